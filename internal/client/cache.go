@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sync"
+)
+
+// responseCache keeps GET responses of rarely changing endpoints (catalogs)
+// for the lifetime of the provider process. It is keyed by full URL and
+// shared between regional clients.
+type responseCache struct {
+	mu      sync.Mutex
+	entries map[string][]byte
+}
+
+func newResponseCache() *responseCache {
+	return &responseCache{entries: map[string][]byte{}}
+}
+
+// GetJSONCached is like GetJSON, but serves repeated requests for the same URL
+// from memory. Use it only for endpoints whose content does not change
+// during a Terraform run.
+func (c *Client) GetJSONCached(ctx context.Context, ep string, q url.Values, out any) error {
+	u, err := c.buildURL(ep, q)
+	if err != nil {
+		return err
+	}
+
+	c.cache.mu.Lock()
+	b, ok := c.cache.entries[u]
+	c.cache.mu.Unlock()
+
+	if !ok {
+		b, _, err = c.doJSON(ctx, http.MethodGet, u, nil)
+		if err != nil {
+			return err
+		}
+		c.cache.mu.Lock()
+		c.cache.entries[u] = b
+		c.cache.mu.Unlock()
+	}
+	return json.Unmarshal(b, out)
+}
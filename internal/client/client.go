@@ -4,13 +4,19 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
-	"time"
+	"os"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+
+	"github.com/serverscamp/terraform-provider-scamp/internal/tracing"
 )
 
 const (
@@ -24,27 +30,67 @@ const (
 	VMTemplatesEP    = "/vm-templates"
 	VMsEP            = "/vms"
 	VolumesEP        = "/volumes"
+	RegionsEP        = "/regions"
+
+	// UserAgentEnvVar appends custom segments to the User-Agent header.
+	UserAgentEnvVar = "TF_APPEND_USER_AGENT"
 )
 
+// ErrReadOnly is returned for mutating requests when the client is read-only.
+var ErrReadOnly = errors.New("provider is in read-only mode")
+
 // Client wraps HTTP communication with the SCAMP API.
 type Client struct {
-	BaseURL string
-	Token   string
+	BaseURL   string
+	Token     string
+	UserAgent string
+	// ReadOnly refuses POST/PUT/PATCH/DELETE requests.
+	ReadOnly bool
+	// DefaultTags are merged into the tags of every taggable resource.
+	DefaultTags map[string]string
+	// Region is the region this client targets ("" for the base endpoint).
+	Region  string
 	http    *http.Client
+	regions *regionRegistry
+	cache   *responseCache
 }
 
 // New creates a new SCAMP API client.
-func New(baseURL, token string) *Client {
+func New(baseURL, token string, opts Options) (*Client, error) {
 	if baseURL == "" {
 		baseURL = DefaultBaseURL
 	}
-	return &Client{
-		BaseURL: baseURL,
-		Token:   token,
-		http: &http.Client{
-			Timeout: 60 * time.Second,
-		},
+	httpClient, err := newHTTPClient(opts)
+	if err != nil {
+		return nil, err
+	}
+	c := &Client{
+		BaseURL:     baseURL,
+		Token:       token,
+		UserAgent:   buildUserAgent(opts.ProviderVersion, opts.TerraformVersion),
+		ReadOnly:    opts.ReadOnly,
+		DefaultTags: opts.DefaultTags,
+		http:        httpClient,
+		cache:       newResponseCache(),
+	}
+	c.regions = newRegionRegistry(c)
+	return c, nil
+}
+
+// buildUserAgent returns "terraform-provider-scamp/<version> terraform/<version>"
+// followed by any segments from TF_APPEND_USER_AGENT.
+func buildUserAgent(providerVersion, terraformVersion string) string {
+	if providerVersion == "" {
+		providerVersion = "dev"
+	}
+	parts := []string{"terraform-provider-scamp/" + providerVersion}
+	if terraformVersion != "" {
+		parts = append(parts, "terraform/"+terraformVersion)
 	}
+	if extra := strings.TrimSpace(os.Getenv(UserAgentEnvVar)); extra != "" {
+		parts = append(parts, extra)
+	}
+	return strings.Join(parts, " ")
 }
 
 // buildURL constructs full URL from endpoint and optional query params.
@@ -66,8 +112,64 @@ type APIError struct {
 	Error   string `json:"error"`
 }
 
+// StatusError is returned for API responses with a 4xx or 5xx status code.
+type StatusError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("http %d: %s", e.StatusCode, e.Message)
+}
+
+// IsNotFound reports whether err is an API 404 response.
+func IsNotFound(err error) bool {
+	var se *StatusError
+	return errors.As(err, &se) && se.StatusCode == http.StatusNotFound
+}
+
+// IsConflict reports whether err is an API 409 response or another client
+// error saying the object is still in use by something else.
+func IsConflict(err error) bool {
+	var se *StatusError
+	if !errors.As(err, &se) {
+		return false
+	}
+	if se.StatusCode == http.StatusConflict {
+		return true
+	}
+	return se.StatusCode < 500 && strings.Contains(strings.ToLower(se.Message), "in use")
+}
+
+// IsProtected reports whether err is an API client error refusing to delete
+// an object because its deletion protection is enabled.
+func IsProtected(err error) bool {
+	var se *StatusError
+	if !errors.As(err, &se) {
+		return false
+	}
+	return se.StatusCode < 500 && strings.Contains(strings.ToLower(se.Message), "protect")
+}
+
 // doJSON performs HTTP request with JSON body and returns response.
-func (c *Client) doJSON(ctx context.Context, method, fullURL string, payload any) ([]byte, int, error) {
+func (c *Client) doJSON(ctx context.Context, method, fullURL string, payload any) (_ []byte, status int, err error) {
+	ctx, span := tracing.Start(ctx, "HTTP "+method,
+		semconv.HTTPRequestMethodKey.String(method),
+		semconv.URLFull(fullURL),
+		tracing.EndpointKey.String(endpointOf(fullURL)),
+	)
+	defer func() {
+		if status != 0 {
+			span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+		}
+		tracing.EndErr(span, err)
+	}()
+
+	if c.ReadOnly && method != http.MethodGet && method != http.MethodHead {
+		tflog.Warn(ctx, "Refusing mutating request in read-only mode", map[string]any{"method": method, "url": fullURL})
+		return nil, 0, fmt.Errorf("%w: refusing %s %s (disable read_only or unset SCAMP_READ_ONLY to make changes)", ErrReadOnly, method, endpointOf(fullURL))
+	}
+
 	var body io.Reader
 	if payload != nil {
 		b, err := json.Marshal(payload)
@@ -91,6 +193,10 @@ func (c *Client) doJSON(ctx context.Context, method, fullURL string, payload any
 		req.Header.Set("Content-Type", "application/json")
 	}
 	req.Header.Set("Accept", "application/json")
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	propagation.TraceContext{}.Inject(ctx, propagation.HeaderCarrier(req.Header))
 
 	resp, err := c.http.Do(req)
 	if err != nil {
@@ -108,18 +214,27 @@ func (c *Client) doJSON(ctx context.Context, method, fullURL string, payload any
 	if resp.StatusCode >= 400 {
 		var apiErr APIError
 		_ = json.Unmarshal(rb, &apiErr)
+		msg := string(rb)
 		if apiErr.Message != "" {
-			return nil, resp.StatusCode, fmt.Errorf("http %d: %s", resp.StatusCode, apiErr.Message)
-		}
-		if apiErr.Error != "" {
-			return nil, resp.StatusCode, fmt.Errorf("http %d: %s", resp.StatusCode, apiErr.Error)
+			msg = apiErr.Message
+		} else if apiErr.Error != "" {
+			msg = apiErr.Error
 		}
-		return nil, resp.StatusCode, fmt.Errorf("http %d: %s", resp.StatusCode, string(rb))
+		return nil, resp.StatusCode, &StatusError{StatusCode: resp.StatusCode, Message: msg}
 	}
 
 	return rb, resp.StatusCode, nil
 }
 
+// endpointOf returns the URL path used as the span endpoint attribute.
+func endpointOf(fullURL string) string {
+	u, err := url.Parse(fullURL)
+	if err != nil {
+		return fullURL
+	}
+	return u.Path
+}
+
 // GetJSON performs GET request and unmarshals response into out.
 func (c *Client) GetJSON(ctx context.Context, ep string, q url.Values, out any) error {
 	u, err := c.buildURL(ep, q)
@@ -149,6 +264,22 @@ func (c *Client) PostJSON(ctx context.Context, ep string, payload any, out any)
 	return json.Unmarshal(b, out)
 }
 
+// PatchJSON performs PATCH request with payload and unmarshals response into out.
+func (c *Client) PatchJSON(ctx context.Context, ep string, payload any, out any) error {
+	u, err := c.buildURL(ep, nil)
+	if err != nil {
+		return err
+	}
+	b, _, err := c.doJSON(ctx, http.MethodPatch, u, payload)
+	if err != nil {
+		return err
+	}
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(b, out)
+}
+
 // Delete performs DELETE request.
 func (c *Client) Delete(ctx context.Context, ep string) error {
 	u, err := c.buildURL(ep, nil)
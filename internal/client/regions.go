@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/serverscamp/terraform-provider-scamp/internal/models"
+)
+
+// regionRegistry resolves region names to API endpoints and caches one
+// client per region. It is shared by the root client and all regional clients.
+type regionRegistry struct {
+	root *Client
+
+	mu      sync.Mutex
+	list    []models.Region
+	clients map[string]*Client
+}
+
+func newRegionRegistry(root *Client) *regionRegistry {
+	return &regionRegistry{
+		root:    root,
+		clients: map[string]*Client{},
+	}
+}
+
+// Regions returns the regions known to the base API endpoint.
+func (c *Client) Regions(ctx context.Context) ([]models.Region, error) {
+	c.regions.mu.Lock()
+	defer c.regions.mu.Unlock()
+	return c.regions.listLocked(ctx)
+}
+
+// ForRegion returns a client for the given region, creating it on first use.
+// The client's own region returns c itself and an empty region the client for
+// the base endpoint. Inactive regions are allowed, so objects in a retired
+// region can still be read and destroyed; see ForActiveRegion.
+func (c *Client) ForRegion(ctx context.Context, region string) (*Client, error) {
+	if region == c.Region {
+		return c, nil
+	}
+	if region == "" {
+		return c.regions.root, nil
+	}
+
+	reg := c.regions
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
+	if rc, ok := reg.clients[region]; ok {
+		return rc, nil
+	}
+
+	list, err := reg.listLocked(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list regions: %w", err)
+	}
+
+	var names []string
+	for _, r := range list {
+		names = append(names, r.Name)
+		if r.Name != region {
+			continue
+		}
+		baseURL := r.APIURL
+		if baseURL == "" {
+			baseURL = reg.root.BaseURL
+		}
+		rc := &Client{
+			BaseURL:     strings.TrimSuffix(baseURL, "/"),
+			Token:       reg.root.Token,
+			UserAgent:   reg.root.UserAgent,
+			ReadOnly:    reg.root.ReadOnly,
+			DefaultTags: reg.root.DefaultTags,
+			Region:      region,
+			http:        reg.root.http,
+			regions:     reg,
+			cache:       reg.root.cache,
+		}
+		reg.clients[region] = rc
+		return rc, nil
+	}
+
+	sort.Strings(names)
+	return nil, fmt.Errorf("unknown region %q (available: %s)", region, strings.Join(names, ", "))
+}
+
+// ForActiveRegion is ForRegion for creating objects: regions that are no
+// longer active are refused.
+func (c *Client) ForActiveRegion(ctx context.Context, region string) (*Client, error) {
+	rc, err := c.ForRegion(ctx, region)
+	if err != nil || rc.Region == "" {
+		return rc, err
+	}
+
+	reg := c.regions
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
+	list, err := reg.listLocked(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list regions: %w", err)
+	}
+	for _, r := range list {
+		if r.Name == rc.Region && !r.IsActive {
+			return nil, fmt.Errorf("region %q is not active: existing objects can still be read and destroyed, but new ones cannot be created", rc.Region)
+		}
+	}
+	return rc, nil
+}
+
+// listLocked fetches the region list once. Caller must hold mu.
+func (reg *regionRegistry) listLocked(ctx context.Context) ([]models.Region, error) {
+	if reg.list != nil {
+		return reg.list, nil
+	}
+	var listResp models.RegionsListResponse
+	if err := reg.root.GetJSON(ctx, RegionsEP, nil, &listResp); err != nil {
+		return nil, err
+	}
+	reg.list = listResp.Items
+	if reg.list == nil {
+		reg.list = []models.Region{}
+	}
+	return reg.list, nil
+}
@@ -0,0 +1,129 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// DefaultHTTPTimeout is used when Options.HTTPTimeout is not set.
+const DefaultHTTPTimeout = 60 * time.Second
+
+// Options configures the HTTP transport used by the client.
+type Options struct {
+	// CACertFile and CACertPEM add a custom CA to the system trust store.
+	CACertFile string
+	CACertPEM  string
+	// Client certificate and key for mutual TLS, either as files or inline PEM.
+	ClientCertFile string
+	ClientCertPEM  string
+	ClientKeyFile  string
+	ClientKeyPEM   string
+	// InsecureSkipVerify disables server certificate verification.
+	InsecureSkipVerify bool
+	// ProxyURL overrides HTTP(S)_PROXY environment variables when set.
+	ProxyURL string
+	// HTTPTimeout is the per-request timeout (default: 60s).
+	HTTPTimeout time.Duration
+	// ReadOnly makes the client refuse every mutating request.
+	ReadOnly bool
+	// DefaultTags are merged into the tags of every taggable resource.
+	DefaultTags map[string]string
+	// ProviderVersion and TerraformVersion are reported in the User-Agent header.
+	ProviderVersion  string
+	TerraformVersion string
+}
+
+// newHTTPClient builds an http.Client from the given options.
+func newHTTPClient(opts Options) (*http.Client, error) {
+	tlsConfig, err := buildTLSConfig(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	if opts.ProxyURL != "" {
+		proxy, err := url.Parse(opts.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy_url: %w", err)
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	timeout := opts.HTTPTimeout
+	if timeout <= 0 {
+		timeout = DefaultHTTPTimeout
+	}
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+	}, nil
+}
+
+// buildTLSConfig assembles CA pool and client certificates from options.
+func buildTLSConfig(opts Options) (*tls.Config, error) {
+	cfg := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: opts.InsecureSkipVerify,
+	}
+
+	caPEM := []byte(opts.CACertPEM)
+	if opts.CACertFile != "" {
+		b, err := os.ReadFile(opts.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ca_cert_file: %w", err)
+		}
+		caPEM = append(caPEM, '\n')
+		caPEM = append(caPEM, b...)
+	}
+	if len(caPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no valid certificates found in CA bundle")
+		}
+		cfg.RootCAs = pool
+	}
+
+	certPEM, err := pemFromFileOrValue(opts.ClientCertFile, opts.ClientCertPEM, "client_cert_file")
+	if err != nil {
+		return nil, err
+	}
+	keyPEM, err := pemFromFileOrValue(opts.ClientKeyFile, opts.ClientKeyPEM, "client_key_file")
+	if err != nil {
+		return nil, err
+	}
+	if len(certPEM) > 0 || len(keyPEM) > 0 {
+		if len(certPEM) == 0 || len(keyPEM) == 0 {
+			return nil, fmt.Errorf("both client certificate and client key must be set for mTLS")
+		}
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate or key: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return cfg, nil
+}
+
+// pemFromFileOrValue returns file contents if path is set, otherwise the inline value.
+func pemFromFileOrValue(path, value, attr string) ([]byte, error) {
+	if path != "" {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", attr, err)
+		}
+		return b, nil
+	}
+	return []byte(value), nil
+}
@@ -30,6 +30,8 @@ type Network struct {
 	CIDR        string  `json:"cidr"`
 	NetworkType string  `json:"network_type,omitempty"` // "private" or "public"
 	RouterUUID  *string `json:"router_uuid"`            // null if not attached
+	Description string            `json:"description,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty"`
 	Status      string  `json:"status"`
 	CreatedAt   string  `json:"created_at,omitempty"`
 }
@@ -53,6 +55,8 @@ type Router struct {
 	Name        string `json:"name"`
 	IPv4Address string `json:"ipv4_address"`
 	IPv6Address string `json:"ipv6_address"`
+	Description string            `json:"description,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty"`
 	Status      string `json:"status"`
 	CreatedAt   string `json:"created_at,omitempty"`
 }
@@ -191,6 +195,8 @@ type VM struct {
 	Limits         *VMLimits      `json:"limits"`
 	OSUser         string         `json:"os_user"`
 	OSPassword     string         `json:"os_password"`
+	Description    string            `json:"description,omitempty"`
+	Tags           map[string]string `json:"tags,omitempty"`
 	Status         string         `json:"status"`
 	State          string         `json:"state"`
 	CreatedAt      string         `json:"created_at,omitempty"`
@@ -236,6 +242,7 @@ type Volume struct {
 	Limits         *VolumeLimits `json:"limits"`
 	SDSPoolName    string        `json:"sds_pool_name"`
 	VMUUID         *string       `json:"vm_uuid"` // null if not attached
+	Tags           map[string]string `json:"tags,omitempty"`
 	State          string        `json:"state"`
 	CreatedAt      string        `json:"created_at,omitempty"`
 	UpdatedAt      string        `json:"updated_at,omitempty"`
@@ -262,3 +269,38 @@ type VolumeAttachResponse struct {
 	VMUUID   string `json:"vm_uuid"`
 	Status   string `json:"status"`
 }
+
+// Region represents a SCAMP location with its own API endpoint.
+type Region struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+	APIURL      string `json:"api_url"`
+	IsActive    bool   `json:"is_active"`
+	IsDefault   bool   `json:"is_default"`
+}
+
+// RegionsListResponse represents GET /regions response.
+type RegionsListResponse struct {
+	Items []Region `json:"items"`
+	Total int      `json:"total"`
+}
+
+// SSHKeyPrivateKey represents GET /ssh-keys/{id}/private-key response.
+type SSHKeyPrivateKey struct {
+	ID         int    `json:"id"`
+	PrivateKey string `json:"private_key"`
+}
+
+// VMCredentials represents GET /vms/{uuid}/credentials response.
+type VMCredentials struct {
+	VMUUID     string `json:"vm_uuid"`
+	OSUser     string `json:"os_user"`
+	OSPassword string `json:"os_password"`
+}
+
+// VMConsoleLog represents GET /vms/{uuid}/console-log response.
+type VMConsoleLog struct {
+	VMUUID    string `json:"vm_uuid"`
+	Output    string `json:"output"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/serverscamp/terraform-provider-scamp/internal/client"
+	"github.com/serverscamp/terraform-provider-scamp/internal/models"
+)
+
+// catalog holds the class and template catalogs of a region, keyed by ID.
+type catalog struct {
+	vmClasses      map[int64]models.VMClass
+	storageClasses map[int64]models.StorageClass
+	networkClasses map[int64]models.NetworkClass
+	templates      map[int64]models.VMTemplate
+}
+
+// fetchCatalog reads all catalogs, including inactive entries. Responses are
+// cached by the client, so calling it from every resource's ModifyPlan costs
+// one request per catalog and region.
+func fetchCatalog(ctx context.Context, c *client.Client) (*catalog, error) {
+	cat := &catalog{
+		vmClasses:      map[int64]models.VMClass{},
+		storageClasses: map[int64]models.StorageClass{},
+		networkClasses: map[int64]models.NetworkClass{},
+		templates:      map[int64]models.VMTemplate{},
+	}
+
+	var vmClasses models.VMClassesListResponse
+	if err := c.GetJSONCached(ctx, client.VMClassesEP, nil, &vmClasses); err != nil {
+		return nil, fmt.Errorf("failed to read VM classes: %w", err)
+	}
+	for _, item := range vmClasses.Items {
+		cat.vmClasses[int64(item.ID)] = item
+	}
+
+	var storageClasses models.StorageClassesListResponse
+	if err := c.GetJSONCached(ctx, client.StorageClassesEP, nil, &storageClasses); err != nil {
+		return nil, fmt.Errorf("failed to read storage classes: %w", err)
+	}
+	for _, item := range storageClasses.Items {
+		cat.storageClasses[int64(item.ID)] = item
+	}
+
+	var networkClasses models.NetworkClassesListResponse
+	if err := c.GetJSONCached(ctx, client.NetworkClassesEP, nil, &networkClasses); err != nil {
+		return nil, fmt.Errorf("failed to read network classes: %w", err)
+	}
+	for _, item := range networkClasses.Items {
+		cat.networkClasses[int64(item.ID)] = item
+	}
+
+	var templates models.VMTemplatesListResponse
+	if err := c.GetJSONCached(ctx, client.VMTemplatesEP, nil, &templates); err != nil {
+		return nil, fmt.Errorf("failed to read VM templates: %w", err)
+	}
+	for _, item := range templates.Items {
+		cat.templates[int64(item.ID)] = item
+	}
+
+	return cat, nil
+}
+
+// knownID returns the value of an ID attribute that is neither null nor unknown.
+func knownID(v types.Int64) (int64, bool) {
+	if v.IsNull() || v.IsUnknown() {
+		return 0, false
+	}
+	return v.ValueInt64(), true
+}
+
+// warnInactive adds a warning at attr if a referenced catalog entry has been retired.
+func warnInactive(diags *diag.Diagnostics, attr, kind, name string, id int64, active bool) {
+	if active {
+		return
+	}
+	diags.AddAttributeWarning(path.Root(attr), fmt.Sprintf("Inactive %s", kind),
+		fmt.Sprintf("%s '%s' (ID %d) is inactive and will be removed from the catalog. "+
+			"Existing resources keep working, but new ones cannot be created with it; migrate to an active %s.",
+			kind, name, id, kind))
+}
+
+// errNotInCatalog adds an error at attr for an ID missing from the catalog.
+func errNotInCatalog(diags *diag.Diagnostics, attr, kind string, id int64) {
+	diags.AddAttributeError(path.Root(attr), fmt.Sprintf("%s not found", kind),
+		fmt.Sprintf("No %s with ID %d exists in the catalog.", kind, id))
+}
+
+// checkDiskSize adds an error at attr if sizeGB exceeds the storage class limit.
+func checkDiskSize(diags *diag.Diagnostics, attr string, sizeGB types.Int64, sc models.StorageClass) {
+	if sizeGB.IsNull() || sizeGB.IsUnknown() || sc.MaxSizeGB <= 0 {
+		return
+	}
+	if sizeGB.ValueInt64() > int64(sc.MaxSizeGB) {
+		diags.AddAttributeError(path.Root(attr), "Disk size exceeds storage class limit",
+			fmt.Sprintf("%d GB exceeds the maximum of %d GB for storage class '%s'.", sizeGB.ValueInt64(), sc.MaxSizeGB, sc.Name))
+	}
+}
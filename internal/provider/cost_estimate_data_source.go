@@ -0,0 +1,331 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	fwds "github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/serverscamp/terraform-provider-scamp/internal/client"
+	"github.com/serverscamp/terraform-provider-scamp/internal/tracing"
+)
+
+type costEstimateDataSource struct {
+	c *client.Client
+}
+
+func NewCostEstimateDataSource() fwds.DataSource { return &costEstimateDataSource{} }
+
+func (d *costEstimateDataSource) Metadata(_ context.Context, _ fwds.MetadataRequest, resp *fwds.MetadataResponse) {
+	resp.TypeName = "scamp_cost_estimate"
+}
+
+func (d *costEstimateDataSource) Schema(_ context.Context, _ fwds.SchemaRequest, resp *fwds.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Description: "Estimates hourly and monthly costs of planned VMs and volumes from catalog prices. " +
+			"Prices are in millicents (10000 = 1 EUR); a month is 730 hours.",
+		Attributes: map[string]dsschema.Attribute{
+			"region": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Region whose catalog prices are used. Defaults to the provider region.",
+			},
+			"vms": dsschema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Planned VM shapes.",
+				NestedObject: dsschema.NestedAttributeObject{
+					Attributes: map[string]dsschema.Attribute{
+						"name": dsschema.StringAttribute{
+							Optional:    true,
+							Description: "Label for the item in the results.",
+						},
+						"vm_class_id": dsschema.Int64Attribute{
+							Required:    true,
+							Description: "ID of the VM class.",
+						},
+						"root_disk_class_id": dsschema.Int64Attribute{
+							Required:    true,
+							Description: "ID of the storage class for the root disk.",
+						},
+						"root_disk_gb": dsschema.Int64Attribute{
+							Required:    true,
+							Description: "Root disk size in GB.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"primary_network_class_id": dsschema.Int64Attribute{
+							Required:    true,
+							Description: "ID of the network class for the primary network.",
+						},
+						"count": dsschema.Int64Attribute{
+							Optional:    true,
+							Description: "Number of VMs with this shape (default: 1).",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"expected_traffic_gb": dsschema.Int64Attribute{
+							Optional:    true,
+							Description: "Expected monthly traffic per VM in GB, used to project overage (default: 0).",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+					},
+				},
+			},
+			"volumes": dsschema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Planned volumes.",
+				NestedObject: dsschema.NestedAttributeObject{
+					Attributes: map[string]dsschema.Attribute{
+						"name": dsschema.StringAttribute{
+							Optional:    true,
+							Description: "Label for the item in the results.",
+						},
+						"storage_class_id": dsschema.Int64Attribute{
+							Required:    true,
+							Description: "ID of the storage class.",
+						},
+						"size_gb": dsschema.Int64Attribute{
+							Required:    true,
+							Description: "Volume size in GB.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"count": dsschema.Int64Attribute{
+							Optional:    true,
+							Description: "Number of volumes with this shape (default: 1).",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"items": dsschema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Cost breakdown per input item, VMs first, then volumes.",
+				NestedObject: dsschema.NestedAttributeObject{
+					Attributes: map[string]dsschema.Attribute{
+						"name": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Label of the item.",
+						},
+						"kind": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Item kind: 'vm' or 'volume'.",
+						},
+						"count": dsschema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of instances.",
+						},
+						"hourly_millicents": dsschema.Float64Attribute{
+							Computed:    true,
+							Description: "Hourly price of all instances in millicents.",
+						},
+						"monthly_millicents": dsschema.Float64Attribute{
+							Computed:    true,
+							Description: "Monthly price of all instances in millicents, including traffic overage.",
+						},
+						"included_traffic_gb": dsschema.Int64Attribute{
+							Computed:    true,
+							Description: "Monthly traffic included for all instances in GB.",
+						},
+						"overage_traffic_gb": dsschema.Int64Attribute{
+							Computed:    true,
+							Description: "Projected monthly traffic above the allowance for all instances in GB.",
+						},
+						"traffic_overage_millicents": dsschema.Float64Attribute{
+							Computed:    true,
+							Description: "Projected monthly price of traffic overage in millicents.",
+						},
+					},
+				},
+			},
+			"total_hourly_millicents": dsschema.Float64Attribute{
+				Computed:    true,
+				Description: "Total hourly price in millicents (excluding traffic overage).",
+			},
+			"total_monthly_millicents": dsschema.Float64Attribute{
+				Computed:    true,
+				Description: "Total monthly price in millicents, including traffic overage.",
+			},
+			"total_monthly_eur": dsschema.Float64Attribute{
+				Computed:    true,
+				Description: "Total monthly price in EUR, including traffic overage.",
+			},
+		},
+	}
+}
+
+func (d *costEstimateDataSource) Configure(_ context.Context, req fwds.ConfigureRequest, _ *fwds.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.c = req.ProviderData.(*client.Client)
+}
+
+type costEstimateVMModel struct {
+	Name                  types.String `tfsdk:"name"`
+	VMClassID             types.Int64  `tfsdk:"vm_class_id"`
+	RootDiskClassID       types.Int64  `tfsdk:"root_disk_class_id"`
+	RootDiskGB            types.Int64  `tfsdk:"root_disk_gb"`
+	PrimaryNetworkClassID types.Int64  `tfsdk:"primary_network_class_id"`
+	Count                 types.Int64  `tfsdk:"count"`
+	ExpectedTrafficGB     types.Int64  `tfsdk:"expected_traffic_gb"`
+}
+
+type costEstimateVolumeModel struct {
+	Name           types.String `tfsdk:"name"`
+	StorageClassID types.Int64  `tfsdk:"storage_class_id"`
+	SizeGB         types.Int64  `tfsdk:"size_gb"`
+	Count          types.Int64  `tfsdk:"count"`
+}
+
+type costEstimateItemModel struct {
+	Name                     types.String  `tfsdk:"name"`
+	Kind                     types.String  `tfsdk:"kind"`
+	Count                    types.Int64   `tfsdk:"count"`
+	HourlyMillicents         types.Float64 `tfsdk:"hourly_millicents"`
+	MonthlyMillicents        types.Float64 `tfsdk:"monthly_millicents"`
+	IncludedTrafficGB        types.Int64   `tfsdk:"included_traffic_gb"`
+	OverageTrafficGB         types.Int64   `tfsdk:"overage_traffic_gb"`
+	TrafficOverageMillicents types.Float64 `tfsdk:"traffic_overage_millicents"`
+}
+
+type costEstimateDataSourceModel struct {
+	Region                 types.String              `tfsdk:"region"`
+	VMs                    []costEstimateVMModel     `tfsdk:"vms"`
+	Volumes                []costEstimateVolumeModel `tfsdk:"volumes"`
+	Items                  []costEstimateItemModel   `tfsdk:"items"`
+	TotalHourlyMillicents  types.Float64             `tfsdk:"total_hourly_millicents"`
+	TotalMonthlyMillicents types.Float64             `tfsdk:"total_monthly_millicents"`
+	TotalMonthlyEUR        types.Float64             `tfsdk:"total_monthly_eur"`
+}
+
+// countOrOne returns v, or 1 when v is not set.
+func countOrOne(v types.Int64) int64 {
+	if v.IsNull() || v.IsUnknown() {
+		return 1
+	}
+	return v.ValueInt64()
+}
+
+func (d *costEstimateDataSource) Read(ctx context.Context, req fwds.ReadRequest, resp *fwds.ReadResponse) {
+	ctx, span := tracing.StartDataSourceRead(ctx, "scamp_cost_estimate")
+	defer tracing.End(span, &resp.Diagnostics)
+
+	var config costEstimateDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := regionalClient(ctx, d.c, config.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+
+	cat, err := fetchCatalog(ctx, c)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read catalog", err.Error())
+		return
+	}
+
+	config.Items = []costEstimateItemModel{}
+	var totalHourly, totalMonthly float64
+
+	for i, vm := range config.VMs {
+		vmClass, ok := cat.vmClasses[vm.VMClassID.ValueInt64()]
+		if !ok {
+			resp.Diagnostics.AddAttributeError(path.Root("vms").AtListIndex(i).AtName("vm_class_id"),
+				"VM class not found", fmt.Sprintf("No VM class with ID %d", vm.VMClassID.ValueInt64()))
+			continue
+		}
+		storageClass, ok := cat.storageClasses[vm.RootDiskClassID.ValueInt64()]
+		if !ok {
+			resp.Diagnostics.AddAttributeError(path.Root("vms").AtListIndex(i).AtName("root_disk_class_id"),
+				"Storage class not found", fmt.Sprintf("No storage class with ID %d", vm.RootDiskClassID.ValueInt64()))
+			continue
+		}
+		networkClass, ok := cat.networkClasses[vm.PrimaryNetworkClassID.ValueInt64()]
+		if !ok {
+			resp.Diagnostics.AddAttributeError(path.Root("vms").AtListIndex(i).AtName("primary_network_class_id"),
+				"Network class not found", fmt.Sprintf("No network class with ID %d", vm.PrimaryNetworkClassID.ValueInt64()))
+			continue
+		}
+
+		count := countOrOne(vm.Count)
+		hourly := vmHourlyMillicents(vmClass.PricePerHourMillicents, storageClass.PricePerGBHourMillicents,
+			vm.RootDiskGB.ValueInt64(), networkClass.PricePerHourMillicents) * float64(count)
+		overageGB, overageCost := trafficOverage(vm.ExpectedTrafficGB.ValueInt64(), int64(networkClass.IncludedTrafficGB),
+			networkClass.TrafficPricePerGBMillicents)
+		overageGB *= count
+		overageCost *= float64(count)
+		monthly := hourly*hoursPerMonth + overageCost
+
+		name := vm.Name.ValueString()
+		if name == "" {
+			name = fmt.Sprintf("vm-%d", i)
+		}
+		config.Items = append(config.Items, costEstimateItemModel{
+			Name:                     types.StringValue(name),
+			Kind:                     types.StringValue("vm"),
+			Count:                    types.Int64Value(count),
+			HourlyMillicents:         types.Float64Value(hourly),
+			MonthlyMillicents:        types.Float64Value(monthly),
+			IncludedTrafficGB:        types.Int64Value(int64(networkClass.IncludedTrafficGB) * count),
+			OverageTrafficGB:         types.Int64Value(overageGB),
+			TrafficOverageMillicents: types.Float64Value(overageCost),
+		})
+		totalHourly += hourly
+		totalMonthly += monthly
+	}
+
+	for i, vol := range config.Volumes {
+		storageClass, ok := cat.storageClasses[vol.StorageClassID.ValueInt64()]
+		if !ok {
+			resp.Diagnostics.AddAttributeError(path.Root("volumes").AtListIndex(i).AtName("storage_class_id"),
+				"Storage class not found", fmt.Sprintf("No storage class with ID %d", vol.StorageClassID.ValueInt64()))
+			continue
+		}
+
+		count := countOrOne(vol.Count)
+		hourly := volumeHourlyMillicents(storageClass.PricePerGBHourMillicents, vol.SizeGB.ValueInt64()) * float64(count)
+		monthly := hourly * hoursPerMonth
+
+		name := vol.Name.ValueString()
+		if name == "" {
+			name = fmt.Sprintf("volume-%d", i)
+		}
+		config.Items = append(config.Items, costEstimateItemModel{
+			Name:                     types.StringValue(name),
+			Kind:                     types.StringValue("volume"),
+			Count:                    types.Int64Value(count),
+			HourlyMillicents:         types.Float64Value(hourly),
+			MonthlyMillicents:        types.Float64Value(monthly),
+			IncludedTrafficGB:        types.Int64Value(0),
+			OverageTrafficGB:         types.Int64Value(0),
+			TrafficOverageMillicents: types.Float64Value(0),
+		})
+		totalHourly += hourly
+		totalMonthly += monthly
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.TotalHourlyMillicents = types.Float64Value(totalHourly)
+	config.TotalMonthlyMillicents = types.Float64Value(totalMonthly)
+	config.TotalMonthlyEUR = types.Float64Value(totalMonthly / millicentsPerEUR)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
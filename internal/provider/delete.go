@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/serverscamp/terraform-provider-scamp/internal/client"
+	"github.com/serverscamp/terraform-provider-scamp/internal/tracing"
+)
+
+// deleteTimeout is the default for timeouts.delete, which bounds how long
+// Delete retries conflicts and waits for the object to disappear. Dependent objects (e.g. a VM on a network) are torn
+// down in parallel by Terraform and may take a few minutes to release.
+const deleteTimeout = 10 * time.Minute
+
+// deleteAndWait deletes the object at ep, retrying while the API reports it
+// as still in use, then polls until GET returns 404. An object that is
+// already gone counts as deleted; one protected by deletion_protection on the
+// server fails right away.
+func deleteAndWait(ctx context.Context, c *client.Client, typeName, uuid, ep string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+
+	for retries := 0; ; retries++ {
+		err := c.Delete(ctx, ep)
+		if err == nil || client.IsNotFound(err) {
+			break
+		}
+		// The server-side lock never releases on its own
+		if client.IsProtected(err) || !client.IsConflict(err) {
+			return err
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timeout waiting for %s %s to be released: %w", typeName, uuid, err)
+		}
+		trace.SpanFromContext(ctx).SetAttributes(tracing.RetriesKey.Int(retries + 1))
+		tflog.Debug(ctx, "Object still in use, retrying delete", map[string]any{
+			"uuid":  uuid,
+			"error": err.Error(),
+		})
+		time.Sleep(5 * time.Second)
+	}
+
+	for attempt := 1; ; attempt++ {
+		var obj json.RawMessage
+		pollCtx, span := startPollSpan(ctx, typeName, uuid, attempt)
+		err := c.GetJSON(pollCtx, ep, nil, &obj)
+		if client.IsNotFound(err) {
+			endPollSpan(span, "deleted", nil)
+			return nil
+		}
+		endPollSpan(span, "", err)
+		if err != nil {
+			return err
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timeout waiting for %s %s to be deleted", typeName, uuid)
+		}
+		tflog.Debug(ctx, "Waiting for deletion", map[string]any{"uuid": uuid})
+		time.Sleep(2 * time.Second)
+	}
+}
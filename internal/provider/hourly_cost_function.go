@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type hourlyCostFunction struct{}
+
+func NewHourlyCostFunction() function.Function { return &hourlyCostFunction{} }
+
+func (f *hourlyCostFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "hourly_cost"
+}
+
+func (f *hourlyCostFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Compute the hourly price of a VM in millicents.",
+		Description: "Adds the VM class price, the root disk price (storage class price per GB-hour times disk_gb) " +
+			"and the network class base price. Class arguments accept the scamp_vm_class, scamp_storage_class " +
+			"and scamp_network_class data sources directly. The result is in millicents (10000 = 1 EUR).",
+		Parameters: []function.Parameter{
+			function.ObjectParameter{
+				Name:        "vm_class",
+				Description: "VM class object with price_per_hour_millicents.",
+				AttributeTypes: map[string]attr.Type{
+					"price_per_hour_millicents": types.Float64Type,
+				},
+			},
+			function.ObjectParameter{
+				Name:        "storage_class",
+				Description: "Storage class object with price_per_gb_hour_millicents.",
+				AttributeTypes: map[string]attr.Type{
+					"price_per_gb_hour_millicents": types.Float64Type,
+				},
+			},
+			function.Int64Parameter{
+				Name:        "disk_gb",
+				Description: "Root disk size in GB.",
+			},
+			function.ObjectParameter{
+				Name:        "network_class",
+				Description: "Network class object with price_per_hour_millicents.",
+				AttributeTypes: map[string]attr.Type{
+					"price_per_hour_millicents": types.Float64Type,
+				},
+			},
+		},
+		Return: function.Float64Return{},
+	}
+}
+
+type vmClassPrice struct {
+	PricePerHourMillicents types.Float64 `tfsdk:"price_per_hour_millicents"`
+}
+
+type storageClassPrice struct {
+	PricePerGBHourMillicents types.Float64 `tfsdk:"price_per_gb_hour_millicents"`
+}
+
+type networkClassPrice struct {
+	PricePerHourMillicents types.Float64 `tfsdk:"price_per_hour_millicents"`
+}
+
+func (f *hourlyCostFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var vmClass vmClassPrice
+	var storageClass storageClassPrice
+	var diskGB int64
+	var networkClass networkClassPrice
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &vmClass, &storageClass, &diskGB, &networkClass))
+	if resp.Error != nil {
+		return
+	}
+
+	if diskGB < 0 {
+		resp.Error = function.NewArgumentFuncError(2, "disk_gb must not be negative")
+		return
+	}
+
+	cost := vmHourlyMillicents(
+		vmClass.PricePerHourMillicents.ValueFloat64(),
+		storageClass.PricePerGBHourMillicents.ValueFloat64(),
+		diskGB,
+		networkClass.PricePerHourMillicents.ValueFloat64(),
+	)
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, cost))
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/serverscamp/terraform-provider-scamp/internal/client"
+)
+
+// listPageSize is the number of items requested per page from list endpoints.
+const listPageSize = 100
+
+// listAll fetches every page of a list endpoint using limit/offset pagination.
+// R is the list response model; page extracts its items and the total count
+// reported by the API.
+func listAll[R any, T any](ctx context.Context, c *client.Client, ep string, q url.Values, page func(*R) ([]T, int)) ([]T, error) {
+	all := []T{}
+	offset := 0
+	for {
+		pq := url.Values{}
+		for k, v := range q {
+			pq[k] = v
+		}
+		pq.Set("limit", strconv.Itoa(listPageSize))
+		pq.Set("offset", strconv.Itoa(offset))
+
+		var resp R
+		if err := c.GetJSON(ctx, ep, pq, &resp); err != nil {
+			return nil, err
+		}
+		items, total := page(&resp)
+		all = append(all, items...)
+		offset += len(items)
+		if len(items) == 0 || offset >= total {
+			return all, nil
+		}
+	}
+}
+
+// compileNameRegex compiles an optional name_regex attribute. It returns nil
+// when the attribute is not set and adds an attribute error if it is invalid.
+func compileNameRegex(v types.String, p path.Path, diags *diag.Diagnostics) *regexp.Regexp {
+	if v.IsNull() || v.IsUnknown() || v.ValueString() == "" {
+		return nil
+	}
+	re, err := regexp.Compile(v.ValueString())
+	if err != nil {
+		diags.AddAttributeError(p, "Invalid name_regex", err.Error())
+		return nil
+	}
+	return re
+}
+
+// matchString reports whether want is unset or equal to got.
+func matchString(want types.String, got string) bool {
+	return want.IsNull() || want.IsUnknown() || want.ValueString() == got
+}
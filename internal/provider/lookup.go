@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// lookupArg is one of the mutually exclusive arguments a singular data source
+// can be looked up by.
+type lookupArg struct {
+	name string
+	set  bool
+}
+
+// checkLookupArgs adds an error unless exactly one lookup argument is set.
+func checkLookupArgs(diags *diag.Diagnostics, args ...lookupArg) bool {
+	names := make([]string, 0, len(args))
+	count := 0
+	for _, a := range args {
+		names = append(names, a.name)
+		if a.set {
+			count++
+		}
+	}
+	if count != 1 {
+		diags.AddError("Invalid lookup",
+			fmt.Sprintf("Exactly one of %s must be set.", strings.Join(names, ", ")))
+		return false
+	}
+	return true
+}
+
+// selectOne returns the single element of matches. Several matches are an
+// error unless mostRecent is set, in which case the newest by created_at wins.
+func selectOne[T any](matches []T, createdAt func(T) string, mostRecent bool, kind string, diags *diag.Diagnostics) (T, bool) {
+	var zero T
+	switch {
+	case len(matches) == 0:
+		diags.AddError(fmt.Sprintf("No %s found", kind),
+			fmt.Sprintf("No %s matches the given criteria.", kind))
+		return zero, false
+	case len(matches) == 1:
+		return matches[0], true
+	case !mostRecent:
+		diags.AddError(fmt.Sprintf("Multiple %ss found", kind),
+			fmt.Sprintf("%d %ss match the given criteria. Narrow the lookup or set most_recent = true.", len(matches), kind))
+		return zero, false
+	}
+
+	newest := matches[0]
+	for _, m := range matches[1:] {
+		if createdAfter(createdAt(m), createdAt(newest)) {
+			newest = m
+		}
+	}
+	return newest, true
+}
+
+// createdAfter reports whether timestamp a is later than b. Timestamps that
+// are not RFC 3339 are compared as strings.
+func createdAfter(a, b string) bool {
+	ta, errA := time.Parse(time.RFC3339Nano, a)
+	tb, errB := time.Parse(time.RFC3339Nano, b)
+	if errA != nil || errB != nil {
+		return a > b
+	}
+	return ta.After(tb)
+}
+
+// cheapest returns the item with the lowest price, ties broken by lowest ID
+// so the selection is stable across runs.
+func cheapest[T any](items []T, price func(T) float64, id func(T) int) (T, bool) {
+	var best T
+	if len(items) == 0 {
+		return best, false
+	}
+	best = items[0]
+	for _, item := range items[1:] {
+		if price(item) < price(best) || (price(item) == price(best) && id(item) < id(best)) {
+			best = item
+		}
+	}
+	return best, true
+}
+
+// atLeast reports whether the lower bound is unset or v >= lower.
+func atLeast(lower types.Int64, v int) bool {
+	return lower.IsNull() || lower.IsUnknown() || int64(v) >= lower.ValueInt64()
+}
+
+// atMost reports whether the upper bound is unset or v <= upper.
+func atMost(upper types.Float64, v float64) bool {
+	return upper.IsNull() || upper.IsUnknown() || v <= upper.ValueFloat64()
+}
+
+// anySet reports whether any of the given selector attributes is set.
+func anySet(values ...attr.Value) bool {
+	for _, v := range values {
+		if !v.IsNull() {
+			return true
+		}
+	}
+	return false
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/serverscamp/terraform-provider-scamp/internal/client"
 	"github.com/serverscamp/terraform-provider-scamp/internal/models"
+	"github.com/serverscamp/terraform-provider-scamp/internal/tracing"
 )
 
 type networkClassDataSource struct {
@@ -24,11 +25,36 @@ func (d *networkClassDataSource) Metadata(_ context.Context, _ fwds.MetadataRequ
 
 func (d *networkClassDataSource) Schema(_ context.Context, _ fwds.SchemaRequest, resp *fwds.SchemaResponse) {
 	resp.Schema = dsschema.Schema{
-		Description: "Find a network class by name (e.g., '100 Mbit', '1 Gbit').",
+		Description: "Find a network class by name (e.g., '100 Mbit', '1 Gbit'), or select the cheapest active class satisfying the given constraints.",
 		Attributes: map[string]dsschema.Attribute{
+			"region": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Region to read from. Defaults to the provider region.",
+			},
+			"include_inactive": dsschema.BoolAttribute{
+				Optional:    true,
+				Description: "Also include retired (inactive) network classes.",
+			},
 			"name": dsschema.StringAttribute{
-				Required:    true,
-				Description: "Name of the network class to search for.",
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the network class to search for. Either name or at least one constraint must be set.",
+			},
+			"min_download_mbit": dsschema.Int64Attribute{
+				Optional:    true,
+				Description: "Minimum download speed limit in Mbit/s.",
+			},
+			"min_upload_mbit": dsschema.Int64Attribute{
+				Optional:    true,
+				Description: "Minimum upload speed limit in Mbit/s.",
+			},
+			"min_included_traffic_gb": dsschema.Int64Attribute{
+				Optional:    true,
+				Description: "Minimum included traffic in GB per month.",
+			},
+			"max_price_per_hour": dsschema.Float64Attribute{
+				Optional:    true,
+				Description: "Maximum base price per hour in millicents.",
 			},
 			"id": dsschema.Int64Attribute{
 				Computed:    true,
@@ -58,6 +84,10 @@ func (d *networkClassDataSource) Schema(_ context.Context, _ fwds.SchemaRequest,
 				Computed:    true,
 				Description: "Price per GB over limit in millicents.",
 			},
+			"is_active": dsschema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the network class is active. Inactive entries are retired and cannot be used for new resources.",
+			},
 		},
 	}
 }
@@ -71,6 +101,10 @@ func (d *networkClassDataSource) Configure(_ context.Context, req fwds.Configure
 
 type networkClassDataSourceModel struct {
 	Name                        types.String  `tfsdk:"name"`
+	MinDownloadMbit             types.Int64   `tfsdk:"min_download_mbit"`
+	MinUploadMbit               types.Int64   `tfsdk:"min_upload_mbit"`
+	MinIncludedTrafficGB        types.Int64   `tfsdk:"min_included_traffic_gb"`
+	MaxPricePerHour             types.Float64 `tfsdk:"max_price_per_hour"`
 	ID                          types.Int64   `tfsdk:"id"`
 	Description                 types.String  `tfsdk:"description"`
 	DownloadMbitLimit           types.Int64   `tfsdk:"download_mbit_limit"`
@@ -78,40 +112,73 @@ type networkClassDataSourceModel struct {
 	IncludedTrafficGB           types.Int64   `tfsdk:"included_traffic_gb"`
 	PricePerHourMillicents      types.Float64 `tfsdk:"price_per_hour_millicents"`
 	TrafficPricePerGBMillicents types.Float64 `tfsdk:"traffic_price_per_gb_millicents"`
+	IncludeInactive             types.Bool    `tfsdk:"include_inactive"`
+	IsActive                    types.Bool    `tfsdk:"is_active"`
+	Region                      types.String  `tfsdk:"region"`
 }
 
 func (d *networkClassDataSource) Read(ctx context.Context, req fwds.ReadRequest, resp *fwds.ReadResponse) {
+	ctx, span := tracing.StartDataSourceRead(ctx, "scamp_network_class")
+	defer tracing.End(span, &resp.Diagnostics)
+
 	var config networkClassDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	name := config.Name.ValueString()
+	if !anySet(config.Name, config.MinDownloadMbit, config.MinUploadMbit, config.MinIncludedTrafficGB, config.MaxPricePerHour) {
+		resp.Diagnostics.AddError("Invalid lookup", "Either name or at least one of min_download_mbit, min_upload_mbit, "+
+			"min_included_traffic_gb, max_price_per_hour must be set.")
+		return
+	}
+
+	c := regionalClient(ctx, d.c, config.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
 
 	var listResp models.NetworkClassesListResponse
-	err := d.c.GetJSON(ctx, client.NetworkClassesEP, nil, &listResp)
+	err := c.GetJSON(ctx, client.NetworkClassesEP, nil, &listResp)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read network classes", err.Error())
 		return
 	}
 
+	var matches []models.NetworkClass
 	for _, item := range listResp.Items {
-		if !item.IsActive {
+		if (!item.IsActive && !config.IncludeInactive.ValueBool()) || !matchString(config.Name, item.Name) {
 			continue
 		}
-		if item.Name == name {
-			config.ID = types.Int64Value(int64(item.ID))
-			config.Description = types.StringValue(item.Description)
-			config.DownloadMbitLimit = types.Int64Value(int64(item.DownloadMbitLimit))
-			config.UploadMbitLimit = types.Int64Value(int64(item.UploadMbitLimit))
-			config.IncludedTrafficGB = types.Int64Value(int64(item.IncludedTrafficGB))
-			config.PricePerHourMillicents = types.Float64Value(item.PricePerHourMillicents)
-			config.TrafficPricePerGBMillicents = types.Float64Value(item.TrafficPricePerGBMillicents)
-			resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
-			return
+		if !atLeast(config.MinDownloadMbit, item.DownloadMbitLimit) ||
+			!atLeast(config.MinUploadMbit, item.UploadMbitLimit) ||
+			!atLeast(config.MinIncludedTrafficGB, item.IncludedTrafficGB) ||
+			!atMost(config.MaxPricePerHour, item.PricePerHourMillicents) {
+			continue
 		}
+		matches = append(matches, item)
+	}
+
+	item, ok := cheapest(matches,
+		func(v models.NetworkClass) float64 { return v.PricePerHourMillicents },
+		func(v models.NetworkClass) int { return v.ID })
+	if !ok {
+		if !config.Name.IsNull() {
+			resp.Diagnostics.AddError("Network class not found", fmt.Sprintf("No active network class with name '%s' satisfies the given constraints", config.Name.ValueString()))
+		} else {
+			resp.Diagnostics.AddError("Network class not found", "No active network class satisfies the given constraints")
+		}
+		return
 	}
 
-	resp.Diagnostics.AddError("Network class not found", fmt.Sprintf("No active network class with name '%s'", name))
+	config.ID = types.Int64Value(int64(item.ID))
+	config.IsActive = types.BoolValue(item.IsActive)
+	config.Name = types.StringValue(item.Name)
+	config.Description = types.StringValue(item.Description)
+	config.DownloadMbitLimit = types.Int64Value(int64(item.DownloadMbitLimit))
+	config.UploadMbitLimit = types.Int64Value(int64(item.UploadMbitLimit))
+	config.IncludedTrafficGB = types.Int64Value(int64(item.IncludedTrafficGB))
+	config.PricePerHourMillicents = types.Float64Value(item.PricePerHourMillicents)
+	config.TrafficPricePerGBMillicents = types.Float64Value(item.TrafficPricePerGBMillicents)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/serverscamp/terraform-provider-scamp/internal/client"
 	"github.com/serverscamp/terraform-provider-scamp/internal/models"
+	"github.com/serverscamp/terraform-provider-scamp/internal/tracing"
 )
 
 type networkClassesDataSource struct {
@@ -25,6 +26,14 @@ func (d *networkClassesDataSource) Schema(_ context.Context, _ fwds.SchemaReques
 	resp.Schema = dsschema.Schema{
 		Description: "Retrieves list of available network classes (speed, traffic configurations).",
 		Attributes: map[string]dsschema.Attribute{
+			"region": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Region to read from. Defaults to the provider region.",
+			},
+			"include_inactive": dsschema.BoolAttribute{
+				Optional:    true,
+				Description: "Also include retired (inactive) network classes.",
+			},
 			"items": dsschema.ListNestedAttribute{
 				Computed:    true,
 				Description: "List of network classes.",
@@ -62,6 +71,10 @@ func (d *networkClassesDataSource) Schema(_ context.Context, _ fwds.SchemaReques
 							Computed:    true,
 							Description: "Price per GB over limit in millicents.",
 						},
+						"is_active": dsschema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the network class is active. Inactive entries are retired and cannot be used for new resources.",
+						},
 					},
 				},
 			},
@@ -85,23 +98,39 @@ type networkClassModel struct {
 	IncludedTrafficGB           types.Int64   `tfsdk:"included_traffic_gb"`
 	PricePerHourMillicents      types.Float64 `tfsdk:"price_per_hour_millicents"`
 	TrafficPricePerGBMillicents types.Float64 `tfsdk:"traffic_price_per_gb_millicents"`
+	IsActive                    types.Bool    `tfsdk:"is_active"`
 }
 
 type networkClassesDataSourceModel struct {
-	Items []networkClassModel `tfsdk:"items"`
+	IncludeInactive types.Bool          `tfsdk:"include_inactive"`
+	Items           []networkClassModel `tfsdk:"items"`
+	Region          types.String        `tfsdk:"region"`
 }
 
 func (d *networkClassesDataSource) Read(ctx context.Context, req fwds.ReadRequest, resp *fwds.ReadResponse) {
+	ctx, span := tracing.StartDataSourceRead(ctx, "scamp_network_classes")
+	defer tracing.End(span, &resp.Diagnostics)
+
+	var state networkClassesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := regionalClient(ctx, d.c, state.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+
 	var listResp models.NetworkClassesListResponse
-	err := d.c.GetJSON(ctx, client.NetworkClassesEP, nil, &listResp)
+	err := c.GetJSON(ctx, client.NetworkClassesEP, nil, &listResp)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read network classes", err.Error())
 		return
 	}
 
-	var state networkClassesDataSourceModel
 	for _, item := range listResp.Items {
-		if !item.IsActive {
+		if !item.IsActive && !state.IncludeInactive.ValueBool() {
 			continue
 		}
 		state.Items = append(state.Items, networkClassModel{
@@ -113,6 +142,7 @@ func (d *networkClassesDataSource) Read(ctx context.Context, req fwds.ReadReques
 			IncludedTrafficGB:           types.Int64Value(int64(item.IncludedTrafficGB)),
 			PricePerHourMillicents:      types.Float64Value(item.PricePerHourMillicents),
 			TrafficPricePerGBMillicents: types.Float64Value(item.TrafficPricePerGBMillicents),
+			IsActive:                    types.BoolValue(item.IsActive),
 		})
 	}
 
@@ -6,10 +6,12 @@ import (
 
 	fwds "github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/serverscamp/terraform-provider-scamp/internal/client"
 	"github.com/serverscamp/terraform-provider-scamp/internal/models"
+	"github.com/serverscamp/terraform-provider-scamp/internal/tracing"
 )
 
 type networkDataSource struct {
@@ -24,15 +26,29 @@ func (d *networkDataSource) Metadata(_ context.Context, _ fwds.MetadataRequest,
 
 func (d *networkDataSource) Schema(_ context.Context, _ fwds.SchemaRequest, resp *fwds.SchemaResponse) {
 	resp.Schema = dsschema.Schema{
-		Description: "Retrieves information about an existing network by UUID.",
+		Description: "Retrieves information about an existing network by UUID, name or name regex.",
 		Attributes: map[string]dsschema.Attribute{
+			"region": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Region to read from. Defaults to the provider region.",
+			},
 			"id": dsschema.StringAttribute{
-				Required:    true,
-				Description: "The UUID of the network to retrieve.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The UUID of the network to retrieve. Exactly one of id, name or name_regex must be set.",
 			},
 			"name": dsschema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
-				Description: "Name of the network.",
+				Description: "Exact name of the network to retrieve.",
+			},
+			"name_regex": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Regular expression matched against network names.",
+			},
+			"most_recent": dsschema.BoolAttribute{
+				Optional:    true,
+				Description: "If several networks match name or name_regex, use the most recently created one instead of failing.",
 			},
 			"cidr": dsschema.StringAttribute{
 				Computed:    true,
@@ -50,11 +66,19 @@ func (d *networkDataSource) Schema(_ context.Context, _ fwds.SchemaRequest, resp
 				Computed:    true,
 				Description: "Current status of the network.",
 			},
+			"tags": dsschema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Tags of the network.",
+			},
 			"created_at": dsschema.StringAttribute{
 				Computed:    true,
 				Description: "Timestamp when the network was created.",
 			},
 		},
+		Blocks: map[string]dsschema.Block{
+			"filter": tagFilterBlock("networks"),
+		},
 	}
 }
 
@@ -66,37 +90,106 @@ func (d *networkDataSource) Configure(_ context.Context, req fwds.ConfigureReque
 }
 
 type networkDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	CIDR        types.String `tfsdk:"cidr"`
-	RouterUUID  types.String `tfsdk:"router_uuid"`
-	NetworkType types.String `tfsdk:"network_type"`
-	Status      types.String `tfsdk:"status"`
-	CreatedAt   types.String `tfsdk:"created_at"`
+	ID          types.String    `tfsdk:"id"`
+	Name        types.String    `tfsdk:"name"`
+	NameRegex   types.String    `tfsdk:"name_regex"`
+	MostRecent  types.Bool      `tfsdk:"most_recent"`
+	CIDR        types.String    `tfsdk:"cidr"`
+	RouterUUID  types.String    `tfsdk:"router_uuid"`
+	NetworkType types.String    `tfsdk:"network_type"`
+	Status      types.String    `tfsdk:"status"`
+	Tags        types.Map       `tfsdk:"tags"`
+	CreatedAt   types.String    `tfsdk:"created_at"`
+	Filter      *tagFilterModel `tfsdk:"filter"`
+	Region      types.String    `tfsdk:"region"`
 }
 
 func (d *networkDataSource) Read(ctx context.Context, req fwds.ReadRequest, resp *fwds.ReadResponse) {
+	ctx, span := tracing.StartDataSourceRead(ctx, "scamp_network")
+	defer tracing.End(span, &resp.Diagnostics)
+
 	var config networkDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	uuid := config.ID.ValueString()
+	// Tag filters narrow any lookup and can also be used on their own
+	tags, tagsAny := config.Filter.tags(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(tags) == 0 && len(tagsAny) == 0 || anySet(config.ID, config.Name, config.NameRegex) {
+		if !checkLookupArgs(&resp.Diagnostics,
+			lookupArg{"id", !config.ID.IsNull()},
+			lookupArg{"name", !config.Name.IsNull()},
+			lookupArg{"name_regex", !config.NameRegex.IsNull()},
+		) {
+			return
+		}
+	}
+	nameRe := compileNameRegex(config.NameRegex, path.Root("name_regex"), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	var network models.Network
-	err := d.c.GetJSON(ctx, fmt.Sprintf("%s/%s", client.NetworksEP, uuid), nil, &network)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to read network", err.Error())
+	c := regionalClient(ctx, d.c, config.Region, &resp.Diagnostics)
+	if c == nil {
 		return
 	}
 
+	var network models.Network
+	if !config.ID.IsNull() {
+		uuid := config.ID.ValueString()
+		tracing.SetUUID(ctx, uuid)
+
+		err := c.GetJSON(ctx, fmt.Sprintf("%s/%s", client.NetworksEP, uuid), nil, &network)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read network", err.Error())
+			return
+		}
+		if !hasAllTags(network.Tags, tags) || !hasAnyTag(network.Tags, tagsAny) {
+			resp.Diagnostics.AddError("No network found", fmt.Sprintf("Network %s does not match the given tags.", uuid))
+			return
+		}
+	} else {
+		networks, err := listAll(ctx, c, client.NetworksEP, tagQuery(tagQuery(nil, "tag", tags), "tag_any", tagsAny), func(r *models.NetworksListResponse) ([]models.Network, int) {
+			return r.Items, r.Total
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to list networks", err.Error())
+			return
+		}
+
+		var matches []models.Network
+		for _, item := range networks {
+			if !hasAllTags(item.Tags, tags) || !hasAnyTag(item.Tags, tagsAny) {
+				continue
+			}
+			if !matchString(config.Name, item.Name) {
+				continue
+			}
+			if nameRe != nil && !nameRe.MatchString(item.Name) {
+				continue
+			}
+			matches = append(matches, item)
+		}
+
+		var ok bool
+		network, ok = selectOne(matches, func(n models.Network) string { return n.CreatedAt }, config.MostRecent.ValueBool(), "network", &resp.Diagnostics)
+		if !ok {
+			return
+		}
+		tracing.SetUUID(ctx, network.NetworkUUID)
+	}
+
 	config.ID = types.StringValue(network.NetworkUUID)
 	config.Name = types.StringValue(network.Name)
 	config.CIDR = types.StringValue(network.CIDR)
 	config.NetworkType = types.StringValue(network.NetworkType)
 	config.Status = types.StringValue(network.Status)
 	config.CreatedAt = types.StringValue(network.CreatedAt)
+	config.Tags = tagsValue(network.Tags)
 
 	if network.RouterUUID != nil && *network.RouterUUID != "" {
 		config.RouterUUID = types.StringValue(*network.RouterUUID)
@@ -5,15 +5,19 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/serverscamp/terraform-provider-scamp/internal/client"
 	"github.com/serverscamp/terraform-provider-scamp/internal/models"
+	"github.com/serverscamp/terraform-provider-scamp/internal/tracing"
 )
 
 type networkResource struct {
@@ -26,10 +30,11 @@ func (r *networkResource) Metadata(_ context.Context, _ tfresource.MetadataReque
 	resp.TypeName = "scamp_network"
 }
 
-func (r *networkResource) Schema(_ context.Context, _ tfresource.SchemaRequest, resp *tfresource.SchemaResponse) {
+func (r *networkResource) Schema(ctx context.Context, _ tfresource.SchemaRequest, resp *tfresource.SchemaResponse) {
 	resp.Schema = rschema.Schema{
 		Description: "Manages a network in SCAMP. Use type='private' for isolated networks, type='public' with router_uuid for internet-connected networks.",
 		Attributes: map[string]rschema.Attribute{
+			"region": regionAttribute(),
 			"id": rschema.StringAttribute{
 				Computed:    true,
 				Description: "The UUID of the network.",
@@ -41,6 +46,9 @@ func (r *networkResource) Schema(_ context.Context, _ tfresource.SchemaRequest,
 				Optional:    true,
 				Computed:    true,
 				Description: "Name of the network (1-64 characters). Auto-generated if not provided.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -49,6 +57,9 @@ func (r *networkResource) Schema(_ context.Context, _ tfresource.SchemaRequest,
 				Optional:    true,
 				Computed:    true,
 				Description: "CIDR block for the network (e.g., 10.50.0.0/24). Auto-generated if not provided.",
+				Validators: []validator.String{
+					cidrValidator{},
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
@@ -57,6 +68,9 @@ func (r *networkResource) Schema(_ context.Context, _ tfresource.SchemaRequest,
 			"type": rschema.StringAttribute{
 				Required:    true,
 				Description: "Type of network: 'private' (isolated) or 'public' (attached to router, requires router_uuid).",
+				Validators: []validator.String{
+					stringvalidator.OneOf("private", "public"),
+				},
 			},
 			"router_uuid": rschema.StringAttribute{
 				Optional:    true,
@@ -71,6 +85,9 @@ func (r *networkResource) Schema(_ context.Context, _ tfresource.SchemaRequest,
 				ElementType: types.StringType,
 				Description: "Tags to assign to the network as key-value pairs.",
 			},
+			"tags_all":            tagsAllAttribute("network"),
+			"delete_on_failure":   deleteOnFailureAttribute("network"),
+			"deletion_protection": deletionProtectionAttribute("network"),
 			"status": rschema.StringAttribute{
 				Computed:    true,
 				Description: "Current status of the network (provision_queued, active, etc.).",
@@ -83,6 +100,9 @@ func (r *networkResource) Schema(_ context.Context, _ tfresource.SchemaRequest,
 				},
 			},
 		},
+		Blocks: map[string]rschema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Delete: true}),
+		},
 	}
 }
 
@@ -94,15 +114,20 @@ func (r *networkResource) Configure(_ context.Context, req tfresource.ConfigureR
 }
 
 type networkModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	CIDR        types.String `tfsdk:"cidr"`
-	Type        types.String `tfsdk:"type"`
-	RouterUUID  types.String `tfsdk:"router_uuid"`
-	Description types.String `tfsdk:"description"`
-	Tags        types.Map    `tfsdk:"tags"`
-	Status      types.String `tfsdk:"status"`
-	CreatedAt   types.String `tfsdk:"created_at"`
+	ID                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	CIDR               types.String   `tfsdk:"cidr"`
+	Type               types.String   `tfsdk:"type"`
+	RouterUUID         types.String   `tfsdk:"router_uuid"`
+	Description        types.String   `tfsdk:"description"`
+	Tags               types.Map      `tfsdk:"tags"`
+	TagsAll            types.Map      `tfsdk:"tags_all"`
+	DeleteOnFailure    types.Bool     `tfsdk:"delete_on_failure"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	Status             types.String   `tfsdk:"status"`
+	CreatedAt          types.String   `tfsdk:"created_at"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+	Region             types.String   `tfsdk:"region"`
 }
 
 func (r *networkResource) setModelFromNetwork(m *networkModel, n *models.Network) {
@@ -111,6 +136,9 @@ func (r *networkResource) setModelFromNetwork(m *networkModel, n *models.Network
 	m.CIDR = types.StringValue(n.CIDR)
 	m.Type = types.StringValue(n.NetworkType)
 	m.Status = types.StringValue(n.Status)
+	m.Description = descriptionFromAPI(m.Description, n.Description)
+	m.Tags = tagsFromAPI(m.Tags, n.Tags, r.c.DefaultTags)
+	m.TagsAll = tagsValue(n.Tags)
 	if n.CreatedAt != "" {
 		m.CreatedAt = types.StringValue(n.CreatedAt)
 	}
@@ -121,18 +149,23 @@ func (r *networkResource) setModelFromNetwork(m *networkModel, n *models.Network
 	}
 }
 
-// waitForNetworkActive polls until network status is "active" or timeout.
-func (r *networkResource) waitForNetworkActive(ctx context.Context, uuid string, timeout time.Duration) (*models.Network, error) {
+// waitForNetworkActive polls until network status is "active", a failed status or timeout.
+func waitForNetworkActive(ctx context.Context, c *client.Client, uuid string, timeout time.Duration) (*models.Network, error) {
 	deadline := time.Now().Add(timeout)
-	for {
+	for attempt := 1; ; attempt++ {
 		var network models.Network
-		err := r.c.GetJSON(ctx, fmt.Sprintf("%s/%s", client.NetworksEP, uuid), nil, &network)
+		pollCtx, span := startPollSpan(ctx, "scamp_network", uuid, attempt)
+		err := c.GetJSON(pollCtx, fmt.Sprintf("%s/%s", client.NetworksEP, uuid), nil, &network)
+		endPollSpan(span, network.Status, err)
 		if err != nil {
 			return nil, err
 		}
 		if network.Status == "active" {
 			return &network, nil
 		}
+		if isFailedState(network.Status) {
+			return &network, fmt.Errorf("network %s failed to provision (status: %s)", uuid, network.Status)
+		}
 		if time.Now().After(deadline) {
 			return &network, fmt.Errorf("timeout waiting for network %s to become active (current status: %s)", uuid, network.Status)
 		}
@@ -141,33 +174,44 @@ func (r *networkResource) waitForNetworkActive(ctx context.Context, uuid string,
 	}
 }
 
+func (r *networkResource) ConfigValidators(_ context.Context) []tfresource.ConfigValidator {
+	return []tfresource.ConfigValidator{
+		networkRouterValidator{},
+	}
+}
+
+func (r *networkResource) ModifyPlan(ctx context.Context, req tfresource.ModifyPlanRequest, resp *tfresource.ModifyPlanResponse) {
+	checkDeletionProtection(ctx, req, resp, "network", "cidr", "region")
+
+	// Nothing to plan on destroy or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.c == nil {
+		return
+	}
+	planTagsAll(ctx, r.c, req, resp)
+}
+
 func (r *networkResource) Create(ctx context.Context, req tfresource.CreateRequest, resp *tfresource.CreateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "scamp_network", "Create")
+	defer tracing.End(span, &resp.Diagnostics)
+
 	var plan networkModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	c := activeRegionalClient(ctx, r.c, plan.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+	plan.Region = types.StringValue(c.Region)
+
 	networkType := plan.Type.ValueString()
 	routerUUID := ""
 	if !plan.RouterUUID.IsNull() {
 		routerUUID = plan.RouterUUID.ValueString()
 	}
 
-	// Validate type and router_uuid combination
-	if networkType != "private" && networkType != "public" {
-		resp.Diagnostics.AddError("Invalid network type", "type must be 'private' or 'public'")
-		return
-	}
-	if networkType == "public" && routerUUID == "" {
-		resp.Diagnostics.AddError("Missing router_uuid", "router_uuid is required when type='public'")
-		return
-	}
-	if networkType == "private" && routerUUID != "" {
-		resp.Diagnostics.AddError("Invalid configuration", "router_uuid must not be set when type='private'")
-		return
-	}
-
 	// Build payload
 	payload := map[string]any{}
 	if !plan.Name.IsNull() && plan.Name.ValueString() != "" {
@@ -176,21 +220,41 @@ func (r *networkResource) Create(ctx context.Context, req tfresource.CreateReque
 	if !plan.CIDR.IsNull() && plan.CIDR.ValueString() != "" {
 		payload["cidr"] = plan.CIDR.ValueString()
 	}
+	if !plan.Description.IsNull() {
+		payload["description"] = plan.Description.ValueString()
+	}
+	if tags := tagsPayload(ctx, plan.TagsAll, &resp.Diagnostics); len(tags) > 0 {
+		payload["tags"] = tags
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.DeletionProtection.ValueBool() {
+		// Server-side lock; also blocks deletion from the web console and API
+		payload["deletion_protection"] = true
+	}
 
 	// Create network
 	var network models.Network
-	if err := r.c.PostJSON(ctx, client.NetworksEP, payload, &network); err != nil {
+	if err := c.PostJSON(ctx, client.NetworksEP, payload, &network); err != nil {
 		resp.Diagnostics.AddError("Failed to create network", err.Error())
 		return
 	}
+	tracing.SetUUID(ctx, network.NetworkUUID)
 
 	// Wait for network to become active
-	activeNetwork, err := r.waitForNetworkActive(ctx, network.NetworkUUID, 2*time.Minute)
-	if err != nil {
-		resp.Diagnostics.AddWarning("Network created but not yet active", err.Error())
-		r.setModelFromNetwork(&plan, &network)
-	} else {
+	activeNetwork, err := waitForNetworkActive(ctx, c, network.NetworkUUID, 2*time.Minute)
+	if activeNetwork != nil {
 		r.setModelFromNetwork(&plan, activeNetwork)
+	} else {
+		r.setModelFromNetwork(&plan, &network)
+	}
+	if err != nil {
+		ep := fmt.Sprintf("%s/%s", client.NetworksEP, network.NetworkUUID)
+		if !provisionFailed(ctx, &resp.Diagnostics, c, "scamp_network", "network", network.NetworkUUID, ep, plan.DeleteOnFailure.ValueBool(), plan.Timeouts, err) {
+			saveTainted(ctx, &resp.State, &plan, &resp.Diagnostics)
+		}
+		return
 	}
 
 	// Attach to router if public
@@ -199,7 +263,7 @@ func (r *networkResource) Create(ctx context.Context, req tfresource.CreateReque
 			"router_uuid": routerUUID,
 		}
 		var attachResp models.NetworkAttachResponse
-		if err := r.c.PostJSON(ctx, fmt.Sprintf("%s/%s/attach", client.NetworksEP, network.NetworkUUID), attachPayload, &attachResp); err != nil {
+		if err := c.PostJSON(ctx, fmt.Sprintf("%s/%s/attach", client.NetworksEP, network.NetworkUUID), attachPayload, &attachResp); err != nil {
 			resp.Diagnostics.AddError("Failed to attach network to router", err.Error())
 			return
 		}
@@ -211,40 +275,48 @@ func (r *networkResource) Create(ctx context.Context, req tfresource.CreateReque
 }
 
 func (r *networkResource) Read(ctx context.Context, req tfresource.ReadRequest, resp *tfresource.ReadResponse) {
+	ctx, span := tracing.StartOperation(ctx, "scamp_network", "Read")
+	defer tracing.End(span, &resp.Diagnostics)
+
 	var state networkModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	c := regionalClient(ctx, r.c, state.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+	state.Region = types.StringValue(c.Region)
+
 	uuid := state.ID.ValueString()
+	tracing.SetUUID(ctx, uuid)
 	if uuid == "" {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 
 	var network models.Network
-	err := r.c.GetJSON(ctx, fmt.Sprintf("%s/%s", client.NetworksEP, uuid), nil, &network)
-	if err != nil {
-		// Assume 404 - resource deleted
+	err := c.GetJSON(ctx, fmt.Sprintf("%s/%s", client.NetworksEP, uuid), nil, &network)
+	if client.IsNotFound(err) {
 		resp.State.RemoveResource(ctx)
 		return
 	}
-
-	// Save local-only fields before overwriting
-	savedDescription := state.Description
-	savedTags := state.Tags
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read network", err.Error())
+		return
+	}
 
 	r.setModelFromNetwork(&state, &network)
 
-	// Restore local-only fields (not in API)
-	state.Description = savedDescription
-	state.Tags = savedTags
-
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *networkResource) Update(ctx context.Context, req tfresource.UpdateRequest, resp *tfresource.UpdateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "scamp_network", "Update")
+	defer tracing.End(span, &resp.Diagnostics)
+
 	var plan, state networkModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -252,7 +324,13 @@ func (r *networkResource) Update(ctx context.Context, req tfresource.UpdateReque
 		return
 	}
 
+	c := regionalClient(ctx, r.c, plan.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+
 	uuid := state.ID.ValueString()
+	tracing.SetUUID(ctx, uuid)
 	oldType := state.Type.ValueString()
 	newType := plan.Type.ValueString()
 	newRouterUUID := ""
@@ -260,25 +338,11 @@ func (r *networkResource) Update(ctx context.Context, req tfresource.UpdateReque
 		newRouterUUID = plan.RouterUUID.ValueString()
 	}
 
-	// Validate new configuration
-	if newType != "private" && newType != "public" {
-		resp.Diagnostics.AddError("Invalid network type", "type must be 'private' or 'public'")
-		return
-	}
-	if newType == "public" && newRouterUUID == "" {
-		resp.Diagnostics.AddError("Missing router_uuid", "router_uuid is required when type='public'")
-		return
-	}
-	if newType == "private" && newRouterUUID != "" {
-		resp.Diagnostics.AddError("Invalid configuration", "router_uuid must not be set when type='private'")
-		return
-	}
-
 	// Handle type changes
 	if oldType != newType {
 		if oldType == "public" && newType == "private" {
 			// Detach from router
-			if err := r.c.Delete(ctx, fmt.Sprintf("%s/%s/detach", client.NetworksEP, uuid)); err != nil {
+			if err := c.Delete(ctx, fmt.Sprintf("%s/%s/detach", client.NetworksEP, uuid)); err != nil {
 				resp.Diagnostics.AddError("Failed to detach network from router", err.Error())
 				return
 			}
@@ -288,7 +352,7 @@ func (r *networkResource) Update(ctx context.Context, req tfresource.UpdateReque
 				"router_uuid": newRouterUUID,
 			}
 			var attachResp models.NetworkAttachResponse
-			if err := r.c.PostJSON(ctx, fmt.Sprintf("%s/%s/attach", client.NetworksEP, uuid), attachPayload, &attachResp); err != nil {
+			if err := c.PostJSON(ctx, fmt.Sprintf("%s/%s/attach", client.NetworksEP, uuid), attachPayload, &attachResp); err != nil {
 				resp.Diagnostics.AddError("Failed to attach network to router", err.Error())
 				return
 			}
@@ -298,7 +362,7 @@ func (r *networkResource) Update(ctx context.Context, req tfresource.UpdateReque
 		oldRouter := state.RouterUUID.ValueString()
 		if oldRouter != newRouterUUID {
 			// Detach from old, attach to new
-			if err := r.c.Delete(ctx, fmt.Sprintf("%s/%s/detach", client.NetworksEP, uuid)); err != nil {
+			if err := c.Delete(ctx, fmt.Sprintf("%s/%s/detach", client.NetworksEP, uuid)); err != nil {
 				resp.Diagnostics.AddError("Failed to detach network from router", err.Error())
 				return
 			}
@@ -306,53 +370,80 @@ func (r *networkResource) Update(ctx context.Context, req tfresource.UpdateReque
 				"router_uuid": newRouterUUID,
 			}
 			var attachResp models.NetworkAttachResponse
-			if err := r.c.PostJSON(ctx, fmt.Sprintf("%s/%s/attach", client.NetworksEP, uuid), attachPayload, &attachResp); err != nil {
+			if err := c.PostJSON(ctx, fmt.Sprintf("%s/%s/attach", client.NetworksEP, uuid), attachPayload, &attachResp); err != nil {
 				resp.Diagnostics.AddError("Failed to attach network to router", err.Error())
 				return
 			}
 		}
 	}
 
+	if !plan.Description.Equal(state.Description) || !plan.TagsAll.Equal(state.TagsAll) || !plan.DeletionProtection.Equal(state.DeletionProtection) {
+		payload := map[string]any{
+			"description":         plan.Description.ValueString(),
+			"tags":                tagsPayload(ctx, plan.TagsAll, &resp.Diagnostics),
+			"deletion_protection": plan.DeletionProtection.ValueBool(),
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := c.PatchJSON(ctx, fmt.Sprintf("%s/%s", client.NetworksEP, uuid), payload, nil); err != nil {
+			resp.Diagnostics.AddError("Failed to update network", err.Error())
+			return
+		}
+	}
+
 	// Re-read network to get updated state
 	var network models.Network
-	if err := r.c.GetJSON(ctx, fmt.Sprintf("%s/%s", client.NetworksEP, uuid), nil, &network); err != nil {
+	if err := c.GetJSON(ctx, fmt.Sprintf("%s/%s", client.NetworksEP, uuid), nil, &network); err != nil {
 		resp.Diagnostics.AddError("Failed to read network after update", err.Error())
 		return
 	}
 
-	// Save local-only fields before overwriting
-	savedDescription := plan.Description
-	savedTags := plan.Tags
-
 	r.setModelFromNetwork(&plan, &network)
 
-	// Restore local-only fields (not in API)
-	plan.Description = savedDescription
-	plan.Tags = savedTags
-
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *networkResource) Delete(ctx context.Context, req tfresource.DeleteRequest, resp *tfresource.DeleteResponse) {
+	ctx, span := tracing.StartOperation(ctx, "scamp_network", "Delete")
+	defer tracing.End(span, &resp.Diagnostics)
+
 	var state networkModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	timeout, diags := state.Timeouts.Delete(ctx, deleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := regionalClient(ctx, r.c, state.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+
 	uuid := state.ID.ValueString()
+	tracing.SetUUID(ctx, uuid)
 	if uuid == "" {
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		errDeletionProtected(resp, "network")
+		return
+	}
+
 	// Detach from router first if public
 	if state.Type.ValueString() == "public" {
-		_ = r.c.Delete(ctx, fmt.Sprintf("%s/%s/detach", client.NetworksEP, uuid))
+		_ = c.Delete(ctx, fmt.Sprintf("%s/%s/detach", client.NetworksEP, uuid))
 		// Ignore error - might already be detached
 	}
 
 	// Delete network
-	if err := r.c.Delete(ctx, fmt.Sprintf("%s/%s", client.NetworksEP, uuid)); err != nil {
+	if err := deleteAndWait(ctx, c, "scamp_network", uuid, fmt.Sprintf("%s/%s", client.NetworksEP, uuid), timeout); err != nil {
 		resp.Diagnostics.AddError("Failed to delete network", err.Error())
 		return
 	}
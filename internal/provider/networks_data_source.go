@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+
+	fwds "github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/serverscamp/terraform-provider-scamp/internal/client"
+	"github.com/serverscamp/terraform-provider-scamp/internal/models"
+	"github.com/serverscamp/terraform-provider-scamp/internal/tracing"
+)
+
+type networksDataSource struct {
+	c *client.Client
+}
+
+func NewNetworksDataSource() fwds.DataSource { return &networksDataSource{} }
+
+func (d *networksDataSource) Metadata(_ context.Context, _ fwds.MetadataRequest, resp *fwds.MetadataResponse) {
+	resp.TypeName = "scamp_networks"
+}
+
+func (d *networksDataSource) Schema(_ context.Context, _ fwds.SchemaRequest, resp *fwds.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Description: "Retrieves list of networks, optionally filtered.",
+		Attributes: map[string]dsschema.Attribute{
+			"region": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Region to read from. Defaults to the provider region.",
+			},
+			"items": dsschema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of networks.",
+				NestedObject: dsschema.NestedAttributeObject{
+					Attributes: map[string]dsschema.Attribute{
+						"id": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "UUID of the network.",
+						},
+						"name": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the network.",
+						},
+						"cidr": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "CIDR block of the network.",
+						},
+						"router_uuid": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "UUID of the attached router, if any.",
+						},
+						"network_type": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Type of network: 'private' or 'public'.",
+						},
+						"status": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Current status of the network.",
+						},
+						"tags": dsschema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Tags of the network.",
+						},
+						"created_at": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Timestamp when the network was created.",
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]dsschema.Block{
+			"filter": dsschema.SingleNestedBlock{
+				Description: "Only return networks matching all of the given criteria.",
+				Attributes: map[string]dsschema.Attribute{
+					"status": dsschema.StringAttribute{
+						Optional:    true,
+						Description: "Status of the network.",
+					},
+					"name_regex": dsschema.StringAttribute{
+						Optional:    true,
+						Description: "Regular expression matched against the network name.",
+					},
+					"router_uuid": dsschema.StringAttribute{
+						Optional:    true,
+						Description: "UUID of the attached router.",
+					},
+					"tags": dsschema.MapAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "Only return networks having all of the given tags.",
+					},
+					"tags_any": dsschema.MapAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "Only return networks having at least one of the given tags.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *networksDataSource) Configure(_ context.Context, req fwds.ConfigureRequest, _ *fwds.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.c = req.ProviderData.(*client.Client)
+}
+
+type networkItemModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	CIDR        types.String `tfsdk:"cidr"`
+	RouterUUID  types.String `tfsdk:"router_uuid"`
+	NetworkType types.String `tfsdk:"network_type"`
+	Status      types.String `tfsdk:"status"`
+	Tags        types.Map    `tfsdk:"tags"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+type networksFilterModel struct {
+	Status     types.String `tfsdk:"status"`
+	NameRegex  types.String `tfsdk:"name_regex"`
+	RouterUUID types.String `tfsdk:"router_uuid"`
+	Tags       types.Map    `tfsdk:"tags"`
+	TagsAny    types.Map    `tfsdk:"tags_any"`
+}
+
+type networksDataSourceModel struct {
+	Filter *networksFilterModel `tfsdk:"filter"`
+	Items  []networkItemModel   `tfsdk:"items"`
+	Region types.String         `tfsdk:"region"`
+}
+
+func networkItemFromAPI(network models.Network) networkItemModel {
+	item := networkItemModel{
+		ID:          types.StringValue(network.NetworkUUID),
+		Name:        types.StringValue(network.Name),
+		CIDR:        types.StringValue(network.CIDR),
+		RouterUUID:  types.StringNull(),
+		NetworkType: types.StringValue(network.NetworkType),
+		Status:      types.StringValue(network.Status),
+		Tags:        tagsValue(network.Tags),
+		CreatedAt:   types.StringValue(network.CreatedAt),
+	}
+	if network.RouterUUID != nil && *network.RouterUUID != "" {
+		item.RouterUUID = types.StringValue(*network.RouterUUID)
+	}
+	return item
+}
+
+func (d *networksDataSource) Read(ctx context.Context, req fwds.ReadRequest, resp *fwds.ReadResponse) {
+	ctx, span := tracing.StartDataSourceRead(ctx, "scamp_networks")
+	defer tracing.End(span, &resp.Diagnostics)
+
+	var state networksDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := state.Filter
+	if filter == nil {
+		filter = &networksFilterModel{}
+	}
+	nameRe := compileNameRegex(filter.NameRegex, path.Root("filter").AtName("name_regex"), &resp.Diagnostics)
+	tags := tagsPayload(ctx, filter.Tags, &resp.Diagnostics)
+	tagsAny := tagsPayload(ctx, filter.TagsAny, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := regionalClient(ctx, d.c, state.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+
+	q := tagQuery(nil, "tag", tags)
+	q = tagQuery(q, "tag_any", tagsAny)
+	networks, err := listAll(ctx, c, client.NetworksEP, q, func(r *models.NetworksListResponse) ([]models.Network, int) {
+		return r.Items, r.Total
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list networks", err.Error())
+		return
+	}
+
+	state.Items = []networkItemModel{}
+	for _, network := range networks {
+		if !hasAllTags(network.Tags, tags) || !hasAnyTag(network.Tags, tagsAny) {
+			continue
+		}
+		routerUUID := ""
+		if network.RouterUUID != nil {
+			routerUUID = *network.RouterUUID
+		}
+		if !matchString(filter.Status, network.Status) || !matchString(filter.RouterUUID, routerUUID) {
+			continue
+		}
+		if nameRe != nil && !nameRe.MatchString(network.Name) {
+			continue
+		}
+		state.Items = append(state.Items, networkItemFromAPI(network))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
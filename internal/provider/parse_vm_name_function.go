@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// vmNameRegexp matches system VM names such as "vm-1042". The API does not
+// guarantee this format, so names that don't match parse to null parts.
+var vmNameRegexp = regexp.MustCompile(`^([a-z][a-z0-9]*(?:-[a-z][a-z0-9]*)*)-([0-9]+)$`)
+
+var parsedVMNameAttrTypes = map[string]attr.Type{
+	"prefix": types.StringType,
+	"id":     types.Int64Type,
+}
+
+type parseVMNameFunction struct{}
+
+func NewParseVMNameFunction() function.Function { return &parseVMNameFunction{} }
+
+func (f *parseVMNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_vm_name"
+}
+
+func (f *parseVMNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Split a VM system name into its prefix and numeric ID.",
+		Description: "Parses the vm_name attribute of scamp_vm (e.g. vm-1042) into an object with prefix (\"vm\") and id (1042). The API does not guarantee this format: for names that are not of the form <prefix>-<number>, prefix and id are null.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "vm_name",
+				Description: "System name of the VM.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parsedVMNameAttrTypes,
+		},
+	}
+}
+
+func (f *parseVMNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var vmName string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &vmName))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, id := types.StringNull(), types.Int64Null()
+	if m := vmNameRegexp.FindStringSubmatch(vmName); m != nil {
+		// Out-of-range numbers are treated like any other unknown format
+		if n, err := strconv.ParseInt(m[2], 10, 64); err == nil {
+			prefix, id = types.StringValue(m[1]), types.Int64Value(n)
+		}
+	}
+
+	result, diags := types.ObjectValue(parsedVMNameAttrTypes, map[string]attr.Value{
+		"prefix": prefix,
+		"id":     id,
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func runParseVMName(t *testing.T, name string) types.Object {
+	t.Helper()
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(name)}),
+	}
+	resp := &function.RunResponse{
+		Result: function.NewResultData(types.ObjectUnknown(parsedVMNameAttrTypes)),
+	}
+	NewParseVMNameFunction().Run(context.Background(), req, resp)
+	if resp.Error != nil {
+		t.Fatalf("parse_vm_name(%q): unexpected error: %s", name, resp.Error)
+	}
+	obj, ok := resp.Result.Value().(types.Object)
+	if !ok {
+		t.Fatalf("parse_vm_name(%q): result is %T, want types.Object", name, resp.Result.Value())
+	}
+	return obj
+}
+
+func TestParseVMName(t *testing.T) {
+	obj := runParseVMName(t, "vm-1042")
+	attrs := obj.Attributes()
+	if got := attrs["prefix"].(types.String); got.ValueString() != "vm" {
+		t.Errorf("prefix = %s, want vm", got)
+	}
+	if got := attrs["id"].(types.Int64); got.ValueInt64() != 1042 {
+		t.Errorf("id = %s, want 1042", got)
+	}
+}
+
+func TestParseVMNameNonMatching(t *testing.T) {
+	for _, name := range []string{"web-server", "vm_1042", "", "vm-99999999999999999999"} {
+		attrs := runParseVMName(t, name).Attributes()
+		if !attrs["prefix"].IsNull() || !attrs["id"].IsNull() {
+			t.Errorf("parse_vm_name(%q) = %v, want null prefix and id", name, attrs)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package provider
+
+// hoursPerMonth is the average number of hours in a month (365 * 24 / 12),
+// used to project hourly catalog prices to monthly costs.
+const hoursPerMonth = 730
+
+// millicentsPerEUR converts catalog prices (10000 millicents = 1 EUR).
+const millicentsPerEUR = 10000
+
+// vmHourlyMillicents returns the hourly price of a VM with its root disk and
+// primary network, in millicents.
+func vmHourlyMillicents(vmClassPrice, storagePricePerGBHour float64, diskGB int64, networkClassPrice float64) float64 {
+	return vmClassPrice + storagePricePerGBHour*float64(diskGB) + networkClassPrice
+}
+
+// volumeHourlyMillicents returns the hourly price of a volume in millicents.
+func volumeHourlyMillicents(storagePricePerGBHour float64, sizeGB int64) float64 {
+	return storagePricePerGBHour * float64(sizeGB)
+}
+
+// trafficOverage returns the monthly traffic above the included allowance
+// and its price in millicents.
+func trafficOverage(expectedGB, includedGB int64, pricePerGB float64) (overageGB int64, cost float64) {
+	if expectedGB <= includedGB {
+		return 0, 0
+	}
+	overageGB = expectedGB - includedGB
+	return overageGB, float64(overageGB) * pricePerGB
+}
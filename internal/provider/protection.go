@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// deletionProtectionAttribute is shared by the stateful resources.
+func deletionProtectionAttribute(kind string) rschema.BoolAttribute {
+	return rschema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+		Description: fmt.Sprintf("Prevent the %s from being destroyed or replaced (default: false). "+
+			"Must be set to false and applied before the %s can be deleted.", kind, kind),
+	}
+}
+
+// checkDeletionProtection fails the plan when a protected object would be
+// destroyed or replaced. The prior state decides, so protection has to be
+// turned off in a separate apply. replaceAttrs lists the attributes with a
+// RequiresReplace plan modifier; the framework only merges their results
+// after ModifyPlan, so the change is detected here the same way, by
+// comparing plan and state.
+func checkDeletionProtection(ctx context.Context, req tfresource.ModifyPlanRequest, resp *tfresource.ModifyPlanResponse, kind string, replaceAttrs ...string) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var protected types.Bool
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("deletion_protection"), &protected)...)
+	if !protected.ValueBool() {
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.AddError(fmt.Sprintf("Cannot destroy protected %s", kind),
+			fmt.Sprintf("The %s has deletion_protection enabled. Set deletion_protection = false and apply before destroying it.", kind))
+		return
+	}
+
+	if attrs := changedAttributes(req.Plan.Raw, req.State.Raw, replaceAttrs); len(attrs) > 0 {
+		resp.Diagnostics.AddError(fmt.Sprintf("Cannot replace protected %s", kind),
+			fmt.Sprintf("Changing %s requires replacing the %s, but it has deletion_protection enabled. "+
+				"Set deletion_protection = false and apply first, or revert the change.", strings.Join(attrs, ", "), kind))
+	}
+}
+
+// changedAttributes returns the top-level attributes among names whose
+// planned value differs from the prior state. An unknown planned value
+// counts as a change, as it does for RequiresReplace.
+func changedAttributes(plan, state tftypes.Value, names []string) []string {
+	var changed []string
+	for _, name := range names {
+		p := tftypes.NewAttributePath().WithAttributeName(name)
+		planned, _, err := tftypes.WalkAttributePath(plan, p)
+		if err != nil {
+			continue
+		}
+		prior, _, err := tftypes.WalkAttributePath(state, p)
+		if err != nil {
+			continue
+		}
+		if !planned.(tftypes.Value).Equal(prior.(tftypes.Value)) {
+			changed = append(changed, name)
+		}
+	}
+	return changed
+}
+
+// errDeletionProtected reports a Delete of a protected object, e.g. when the
+// plan was created with -refresh=false or by an older provider version.
+func errDeletionProtected(resp *tfresource.DeleteResponse, kind string) {
+	resp.Diagnostics.AddError(fmt.Sprintf("Cannot delete protected %s", kind),
+		fmt.Sprintf("The %s has deletion_protection enabled. Set deletion_protection = false and apply before deleting it.", kind))
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// planNetwork runs the network ModifyPlan for a change from state to plan.
+// Attributes missing from either map are null; a nil plan is a destroy.
+func planNetwork(t *testing.T, state, plan map[string]tftypes.Value) *tfresource.ModifyPlanResponse {
+	t.Helper()
+	ctx := context.Background()
+	r := &networkResource{}
+
+	var schemaResp tfresource.SchemaResponse
+	r.Schema(ctx, tfresource.SchemaRequest{}, &schemaResp)
+	typ := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	object := func(vals map[string]tftypes.Value) tftypes.Value {
+		if vals == nil {
+			return tftypes.NewValue(typ, nil)
+		}
+		attrs := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+		for name, attrType := range typ.AttributeTypes {
+			if v, ok := vals[name]; ok {
+				attrs[name] = v
+			} else {
+				attrs[name] = tftypes.NewValue(attrType, nil)
+			}
+		}
+		return tftypes.NewValue(typ, attrs)
+	}
+
+	req := tfresource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: object(plan)},
+		Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: object(plan)},
+		State:  tfsdk.State{Schema: schemaResp.Schema, Raw: object(state)},
+	}
+	resp := &tfresource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(ctx, req, resp)
+	return resp
+}
+
+func networkValues(cidr, description string, protected bool) map[string]tftypes.Value {
+	return map[string]tftypes.Value{
+		"id":                  tftypes.NewValue(tftypes.String, "net-1"),
+		"cidr":                tftypes.NewValue(tftypes.String, cidr),
+		"description":         tftypes.NewValue(tftypes.String, description),
+		"deletion_protection": tftypes.NewValue(tftypes.Bool, protected),
+		"region":              tftypes.NewValue(tftypes.String, "eu-1"),
+	}
+}
+
+func TestDeletionProtectionBlocksReplace(t *testing.T) {
+	resp := planNetwork(t,
+		networkValues("10.0.0.0/24", "", true),
+		networkValues("10.1.0.0/24", "", true))
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when replacing a protected network")
+	}
+	if got := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(got, "cidr") {
+		t.Errorf("error detail %q does not name cidr", got)
+	}
+}
+
+func TestDeletionProtectionBlocksDestroy(t *testing.T) {
+	resp := planNetwork(t, networkValues("10.0.0.0/24", "", true), nil)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when destroying a protected network")
+	}
+}
+
+func TestDeletionProtectionAllowsInPlaceUpdate(t *testing.T) {
+	resp := planNetwork(t,
+		networkValues("10.0.0.0/24", "old", true),
+		networkValues("10.0.0.0/24", "new", true))
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error for an in-place update: %v", resp.Diagnostics)
+	}
+}
+
+func TestDeletionProtectionAllowsUnprotectedReplace(t *testing.T) {
+	resp := planNetwork(t,
+		networkValues("10.0.0.0/24", "", false),
+		networkValues("10.1.0.0/24", "", false))
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error replacing an unprotected network: %v", resp.Diagnostics)
+	}
+}
@@ -3,8 +3,13 @@ package provider
 import (
 	"context"
 	"os"
+	"strconv"
+	"time"
 
 	fwds "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	fwprov "github.com/hashicorp/terraform-plugin-framework/provider"
 	provschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	fwres "github.com/hashicorp/terraform-plugin-framework/resource"
@@ -14,17 +19,41 @@ import (
 	"github.com/serverscamp/terraform-provider-scamp/internal/client"
 )
 
-type scampProvider struct{}
+type scampProvider struct {
+	version string
+}
 
 type providerData struct {
-	APIURL types.String `tfsdk:"api_url"`
-	Token  types.String `tfsdk:"token"`
+	APIURL             types.String      `tfsdk:"api_url"`
+	Token              types.String      `tfsdk:"token"`
+	CACertFile         types.String      `tfsdk:"ca_cert_file"`
+	CACertPEM          types.String      `tfsdk:"ca_cert_pem"`
+	ClientCertFile     types.String      `tfsdk:"client_cert_file"`
+	ClientCertPEM      types.String      `tfsdk:"client_cert_pem"`
+	ClientKeyFile      types.String      `tfsdk:"client_key_file"`
+	ClientKeyPEM       types.String      `tfsdk:"client_key_pem"`
+	InsecureSkipVerify types.Bool        `tfsdk:"insecure_skip_verify"`
+	ProxyURL           types.String      `tfsdk:"proxy_url"`
+	HTTPTimeout        types.String      `tfsdk:"http_timeout"`
+	ReadOnly           types.Bool        `tfsdk:"read_only"`
+	Region             types.String      `tfsdk:"region"`
+	DefaultTags        *defaultTagsModel `tfsdk:"default_tags"`
+}
+
+type defaultTagsModel struct {
+	Tags types.Map `tfsdk:"tags"`
 }
 
-func New() fwprov.Provider { return &scampProvider{} }
+// New returns a provider factory for the given build version.
+func New(version string) func() fwprov.Provider {
+	return func() fwprov.Provider {
+		return &scampProvider{version: version}
+	}
+}
 
 func (p *scampProvider) Metadata(_ context.Context, _ fwprov.MetadataRequest, resp *fwprov.MetadataResponse) {
 	resp.TypeName = "scamp"
+	resp.Version = p.version
 }
 
 func (p *scampProvider) Schema(_ context.Context, _ fwprov.SchemaRequest, resp *fwprov.SchemaResponse) {
@@ -40,6 +69,63 @@ func (p *scampProvider) Schema(_ context.Context, _ fwprov.SchemaRequest, resp *
 				Sensitive:   true,
 				Description: "API token (starts with sc_). Can also be set via SCAMP_TOKEN env var.",
 			},
+			"ca_cert_file": provschema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a PEM-encoded CA bundle trusted in addition to system roots. Can also be set via SCAMP_CA_CERT_FILE env var.",
+			},
+			"ca_cert_pem": provschema.StringAttribute{
+				Optional:    true,
+				Description: "PEM-encoded CA bundle trusted in addition to system roots.",
+			},
+			"client_cert_file": provschema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a PEM-encoded client certificate for mTLS. Can also be set via SCAMP_CLIENT_CERT_FILE env var.",
+			},
+			"client_cert_pem": provschema.StringAttribute{
+				Optional:    true,
+				Description: "PEM-encoded client certificate for mTLS.",
+			},
+			"client_key_file": provschema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a PEM-encoded client private key for mTLS. Can also be set via SCAMP_CLIENT_KEY_FILE env var.",
+			},
+			"client_key_pem": provschema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "PEM-encoded client private key for mTLS.",
+			},
+			"insecure_skip_verify": provschema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip TLS certificate verification (for lab environments only). Can also be set via SCAMP_INSECURE_SKIP_VERIFY env var.",
+			},
+			"proxy_url": provschema.StringAttribute{
+				Optional:    true,
+				Description: "HTTP(S) proxy URL. Overrides HTTPS_PROXY/HTTP_PROXY env vars. Can also be set via SCAMP_PROXY_URL env var.",
+			},
+			"http_timeout": provschema.StringAttribute{
+				Optional:    true,
+				Description: "Timeout for a single HTTP request as a Go duration (default: 60s). Can also be set via SCAMP_HTTP_TIMEOUT env var.",
+			},
+			"region": provschema.StringAttribute{
+				Optional:    true,
+				Description: "Default region. Resolved to a regional API endpoint via the regions list of api_url. Can also be set via SCAMP_REGION env var.",
+			},
+			"read_only": provschema.BoolAttribute{
+				Optional:    true,
+				Description: "Refuse every create, update and delete API call. Data sources and refresh keep working. Can also be set via SCAMP_READ_ONLY env var.",
+			},
+		},
+		Blocks: map[string]provschema.Block{
+			"default_tags": provschema.SingleNestedBlock{
+				Description: "Tags applied to every taggable resource. Tags set on a resource take precedence.",
+				Attributes: map[string]provschema.Attribute{
+					"tags": provschema.MapAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "Default tags as key-value pairs.",
+					},
+				},
+			},
 		},
 	}
 }
@@ -74,10 +160,89 @@ func (p *scampProvider) Configure(ctx context.Context, req fwprov.ConfigureReque
 		return
 	}
 
-	c := client.New(apiURL, token)
-	tflog.Info(ctx, "Configured SCAMP client", map[string]any{"api_url": apiURL})
+	opts := client.Options{
+		CACertFile:     stringConfigOrEnv(data.CACertFile, "SCAMP_CA_CERT_FILE"),
+		CACertPEM:      data.CACertPEM.ValueString(),
+		ClientCertFile: stringConfigOrEnv(data.ClientCertFile, "SCAMP_CLIENT_CERT_FILE"),
+		ClientCertPEM:  data.ClientCertPEM.ValueString(),
+		ClientKeyFile:  stringConfigOrEnv(data.ClientKeyFile, "SCAMP_CLIENT_KEY_FILE"),
+		ClientKeyPEM:   data.ClientKeyPEM.ValueString(),
+		ProxyURL:       stringConfigOrEnv(data.ProxyURL, "SCAMP_PROXY_URL"),
+
+		ProviderVersion:  p.version,
+		TerraformVersion: req.TerraformVersion,
+	}
+
+	insecure, err := boolConfigOrEnv(data.InsecureSkipVerify, "SCAMP_INSECURE_SKIP_VERIFY")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid SCAMP_INSECURE_SKIP_VERIFY", err.Error())
+		return
+	}
+	opts.InsecureSkipVerify = insecure
+
+	readOnly, err := boolConfigOrEnv(data.ReadOnly, "SCAMP_READ_ONLY")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid SCAMP_READ_ONLY", err.Error())
+		return
+	}
+	opts.ReadOnly = readOnly
+
+	if data.DefaultTags != nil {
+		opts.DefaultTags = tagsPayload(ctx, data.DefaultTags.Tags, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if timeout := stringConfigOrEnv(data.HTTPTimeout, "SCAMP_HTTP_TIMEOUT"); timeout != "" {
+		d, err := time.ParseDuration(timeout)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("http_timeout"), "Invalid http_timeout", err.Error())
+			return
+		}
+		opts.HTTPTimeout = d
+	}
+
+	if opts.InsecureSkipVerify {
+		resp.Diagnostics.AddWarning("TLS verification disabled", "insecure_skip_verify is enabled. Do not use this in production.")
+	}
+
+	c, err := client.New(apiURL, token, opts)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to configure SCAMP client", err.Error())
+		return
+	}
+
+	if region := stringConfigOrEnv(data.Region, "SCAMP_REGION"); region != "" {
+		c, err = c.ForRegion(ctx, region)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("region"), "Invalid region", err.Error())
+			return
+		}
+	}
+	tflog.Info(ctx, "Configured SCAMP client", map[string]any{"api_url": c.BaseURL, "region": c.Region, "user_agent": c.UserAgent, "read_only": c.ReadOnly})
 	resp.DataSourceData = c
 	resp.ResourceData = c
+	resp.EphemeralResourceData = c
+}
+
+// stringConfigOrEnv returns the config value if set, otherwise the env var.
+func stringConfigOrEnv(v types.String, env string) string {
+	if !v.IsNull() && v.ValueString() != "" {
+		return v.ValueString()
+	}
+	return os.Getenv(env)
+}
+
+// boolConfigOrEnv returns the config value if set, otherwise the parsed env var.
+func boolConfigOrEnv(v types.Bool, env string) (bool, error) {
+	if !v.IsNull() {
+		return v.ValueBool(), nil
+	}
+	if s := os.Getenv(env); s != "" {
+		return strconv.ParseBool(s)
+	}
+	return false, nil
 }
 
 func (p *scampProvider) DataSources(_ context.Context) []func() fwds.DataSource {
@@ -95,6 +260,14 @@ func (p *scampProvider) DataSources(_ context.Context) []func() fwds.DataSource
 		NewVMTemplateDataSource,
 		NewVMDataSource,
 		NewVolumeDataSource,
+		NewRegionsDataSource,
+		NewCostEstimateDataSource,
+		NewVMsDataSource,
+		NewVolumesDataSource,
+		NewNetworksDataSource,
+		NewRoutersDataSource,
+		NewSSHKeysDataSource,
+		NewVMConsoleLogDataSource,
 	}
 }
 
@@ -105,5 +278,21 @@ func (p *scampProvider) Resources(_ context.Context) []func() fwres.Resource {
 		NewRouterResource,
 		NewVMResource,
 		NewVolumeResource,
+		NewVolumeAttachmentResource,
+	}
+}
+
+func (p *scampProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewSSHKeyPairEphemeralResource,
+		NewVMCredentialsEphemeralResource,
+	}
+}
+
+func (p *scampProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewSSHFingerprintFunction,
+		NewHourlyCostFunction,
+		NewParseVMNameFunction,
 	}
 }
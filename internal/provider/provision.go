@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/serverscamp/terraform-provider-scamp/internal/client"
+)
+
+// isFailedState reports whether a status or state is terminal, i.e. the
+// object will never become ready and polling can stop.
+func isFailedState(s string) bool {
+	switch s {
+	case "error", "failed", "provision_failed":
+		return true
+	}
+	return false
+}
+
+// deleteOnFailureAttribute is shared by every resource that waits for the
+// object to become ready in Create.
+func deleteOnFailureAttribute(kind string) rschema.BoolAttribute {
+	return rschema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+		Description: fmt.Sprintf("Delete the %s if it fails to become ready during create (default: false). "+
+			"Otherwise it is kept and marked tainted, so the next apply replaces it.", kind),
+	}
+}
+
+// provisionFailed reports an object that did not become ready after create.
+// With deleteOnFailure the object is deleted, bounded by the delete timeout in
+// t, and true is returned, so the caller must not save state. Otherwise the
+// caller saves state along with the error (see saveTainted) and Terraform
+// marks the resource tainted.
+func provisionFailed(ctx context.Context, diags *diag.Diagnostics, c *client.Client, typeName, kind, uuid, ep string, deleteOnFailure bool, t timeouts.Value, cause error) bool {
+	summary := fmt.Sprintf("Failed to provision %s", kind)
+	if !deleteOnFailure {
+		diags.AddError(summary, fmt.Sprintf("%s\n\nThe %s was kept and marked tainted; the next apply will replace it. "+
+			"Set delete_on_failure = true to delete it automatically.", cause, kind))
+		return false
+	}
+	timeout, d := t.Delete(ctx, deleteTimeout)
+	diags.Append(d...)
+	if err := deleteAndWait(ctx, c, typeName, uuid, ep, timeout); err != nil {
+		diags.AddError(summary, fmt.Sprintf("%s\n\nDeleting the failed %s also failed, it was marked tainted instead: %s", cause, kind, err))
+		return false
+	}
+	diags.AddError(summary, fmt.Sprintf("%s\n\nThe %s was deleted because delete_on_failure is set.", cause, kind))
+	return true
+}
+
+// saveTainted saves a resource that failed to provision. Computed values the
+// API never reported are nulled, since Terraform rejects unknown values in
+// the state returned from apply.
+func saveTainted(ctx context.Context, state *tfsdk.State, model any, diags *diag.Diagnostics) {
+	diags.Append(state.Set(ctx, model)...)
+	raw, err := tftypes.Transform(state.Raw, func(_ *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if !v.IsKnown() {
+			return tftypes.NewValue(v.Type(), nil), nil
+		}
+		return v, nil
+	})
+	if err != nil {
+		diags.AddError("Failed to save state", err.Error())
+		return
+	}
+	state.Raw = raw
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/serverscamp/terraform-provider-scamp/internal/client"
+)
+
+// regionalClient returns the client for a per-resource region override,
+// falling back to the provider's client when region is not set.
+func regionalClient(ctx context.Context, c *client.Client, region types.String, diags *diag.Diagnostics) *client.Client {
+	if region.IsNull() || region.IsUnknown() {
+		return c
+	}
+	rc, err := c.ForRegion(ctx, region.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("region"), "Invalid region", err.Error())
+		return nil
+	}
+	return rc
+}
+
+// activeRegionalClient is regionalClient for Create. New objects can only be
+// created in active regions, including the provider's own region.
+func activeRegionalClient(ctx context.Context, c *client.Client, region types.String, diags *diag.Diagnostics) *client.Client {
+	name := c.Region
+	if !region.IsNull() && !region.IsUnknown() {
+		name = region.ValueString()
+	}
+	rc, err := c.ForActiveRegion(ctx, name)
+	if err != nil {
+		diags.AddAttributeError(path.Root("region"), "Invalid region", err.Error())
+		return nil
+	}
+	return rc
+}
+
+// regionAttribute is shared by every resource. The effective region is
+// recorded in state, so changing the provider region later does not move
+// existing resources; an empty string stands for the base api_url endpoint.
+func regionAttribute() rschema.StringAttribute {
+	return rschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Region to manage the resource in. Defaults to the provider region at creation time.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+
+	fwds "github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/serverscamp/terraform-provider-scamp/internal/client"
+	"github.com/serverscamp/terraform-provider-scamp/internal/tracing"
+)
+
+type regionsDataSource struct {
+	c *client.Client
+}
+
+func NewRegionsDataSource() fwds.DataSource { return &regionsDataSource{} }
+
+func (d *regionsDataSource) Metadata(_ context.Context, _ fwds.MetadataRequest, resp *fwds.MetadataResponse) {
+	resp.TypeName = "scamp_regions"
+}
+
+func (d *regionsDataSource) Schema(_ context.Context, _ fwds.SchemaRequest, resp *fwds.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Description: "Retrieves list of SCAMP regions and their API endpoints.",
+		Attributes: map[string]dsschema.Attribute{
+			"current": dsschema.StringAttribute{
+				Computed:    true,
+				Description: "Region configured on the provider (empty if none).",
+			},
+			"items": dsschema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of regions.",
+				NestedObject: dsschema.NestedAttributeObject{
+					Attributes: map[string]dsschema.Attribute{
+						"name": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Region name, used in the region attribute.",
+						},
+						"display_name": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Human-readable name of the region.",
+						},
+						"api_url": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Base API URL of the region.",
+						},
+						"is_active": dsschema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the region accepts new resources.",
+						},
+						"is_default": dsschema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether this is the default region.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *regionsDataSource) Configure(_ context.Context, req fwds.ConfigureRequest, _ *fwds.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.c = req.ProviderData.(*client.Client)
+}
+
+type regionModel struct {
+	Name        types.String `tfsdk:"name"`
+	DisplayName types.String `tfsdk:"display_name"`
+	APIURL      types.String `tfsdk:"api_url"`
+	IsActive    types.Bool   `tfsdk:"is_active"`
+	IsDefault   types.Bool   `tfsdk:"is_default"`
+}
+
+type regionsDataSourceModel struct {
+	Current types.String  `tfsdk:"current"`
+	Items   []regionModel `tfsdk:"items"`
+}
+
+func (d *regionsDataSource) Read(ctx context.Context, req fwds.ReadRequest, resp *fwds.ReadResponse) {
+	ctx, span := tracing.StartDataSourceRead(ctx, "scamp_regions")
+	defer tracing.End(span, &resp.Diagnostics)
+
+	regions, err := d.c.Regions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read regions", err.Error())
+		return
+	}
+
+	state := regionsDataSourceModel{
+		Current: types.StringValue(d.c.Region),
+		Items:   []regionModel{},
+	}
+	for _, item := range regions {
+		state.Items = append(state.Items, regionModel{
+			Name:        types.StringValue(item.Name),
+			DisplayName: types.StringValue(item.DisplayName),
+			APIURL:      types.StringValue(item.APIURL),
+			IsActive:    types.BoolValue(item.IsActive),
+			IsDefault:   types.BoolValue(item.IsDefault),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
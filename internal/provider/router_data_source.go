@@ -6,10 +6,12 @@ import (
 
 	fwds "github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/serverscamp/terraform-provider-scamp/internal/client"
 	"github.com/serverscamp/terraform-provider-scamp/internal/models"
+	"github.com/serverscamp/terraform-provider-scamp/internal/tracing"
 )
 
 type routerDataSource struct {
@@ -24,15 +26,29 @@ func (d *routerDataSource) Metadata(_ context.Context, _ fwds.MetadataRequest, r
 
 func (d *routerDataSource) Schema(_ context.Context, _ fwds.SchemaRequest, resp *fwds.SchemaResponse) {
 	resp.Schema = dsschema.Schema{
-		Description: "Retrieves information about an existing router by UUID.",
+		Description: "Retrieves information about an existing router by UUID, name or name regex.",
 		Attributes: map[string]dsschema.Attribute{
+			"region": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Region to read from. Defaults to the provider region.",
+			},
 			"id": dsschema.StringAttribute{
-				Required:    true,
-				Description: "The UUID of the router to retrieve.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The UUID of the router to retrieve. Exactly one of id, name or name_regex must be set.",
 			},
 			"name": dsschema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
-				Description: "Name of the router.",
+				Description: "Exact name of the router to retrieve.",
+			},
+			"name_regex": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Regular expression matched against router names.",
+			},
+			"most_recent": dsschema.BoolAttribute{
+				Optional:    true,
+				Description: "If several routers match name or name_regex, use the most recently created one instead of failing.",
 			},
 			"ipv4_address": dsschema.StringAttribute{
 				Computed:    true,
@@ -46,11 +62,19 @@ func (d *routerDataSource) Schema(_ context.Context, _ fwds.SchemaRequest, resp
 				Computed:    true,
 				Description: "Current status of the router.",
 			},
+			"tags": dsschema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Tags of the router.",
+			},
 			"created_at": dsschema.StringAttribute{
 				Computed:    true,
 				Description: "Timestamp when the router was created.",
 			},
 		},
+		Blocks: map[string]dsschema.Block{
+			"filter": tagFilterBlock("routers"),
+		},
 	}
 }
 
@@ -62,28 +86,96 @@ func (d *routerDataSource) Configure(_ context.Context, req fwds.ConfigureReques
 }
 
 type routerDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	IPv4Address types.String `tfsdk:"ipv4_address"`
-	IPv6Address types.String `tfsdk:"ipv6_address"`
-	Status      types.String `tfsdk:"status"`
-	CreatedAt   types.String `tfsdk:"created_at"`
+	ID          types.String    `tfsdk:"id"`
+	Name        types.String    `tfsdk:"name"`
+	NameRegex   types.String    `tfsdk:"name_regex"`
+	MostRecent  types.Bool      `tfsdk:"most_recent"`
+	IPv4Address types.String    `tfsdk:"ipv4_address"`
+	IPv6Address types.String    `tfsdk:"ipv6_address"`
+	Status      types.String    `tfsdk:"status"`
+	Tags        types.Map       `tfsdk:"tags"`
+	CreatedAt   types.String    `tfsdk:"created_at"`
+	Filter      *tagFilterModel `tfsdk:"filter"`
+	Region      types.String    `tfsdk:"region"`
 }
 
 func (d *routerDataSource) Read(ctx context.Context, req fwds.ReadRequest, resp *fwds.ReadResponse) {
+	ctx, span := tracing.StartDataSourceRead(ctx, "scamp_router")
+	defer tracing.End(span, &resp.Diagnostics)
+
 	var config routerDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	uuid := config.ID.ValueString()
+	// Tag filters narrow any lookup and can also be used on their own
+	tags, tagsAny := config.Filter.tags(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(tags) == 0 && len(tagsAny) == 0 || anySet(config.ID, config.Name, config.NameRegex) {
+		if !checkLookupArgs(&resp.Diagnostics,
+			lookupArg{"id", !config.ID.IsNull()},
+			lookupArg{"name", !config.Name.IsNull()},
+			lookupArg{"name_regex", !config.NameRegex.IsNull()},
+		) {
+			return
+		}
+	}
+	nameRe := compileNameRegex(config.NameRegex, path.Root("name_regex"), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := regionalClient(ctx, d.c, config.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
 
 	var router models.Router
-	err := d.c.GetJSON(ctx, fmt.Sprintf("%s/%s", client.RoutersEP, uuid), nil, &router)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to read router", err.Error())
-		return
+	if !config.ID.IsNull() {
+		uuid := config.ID.ValueString()
+		tracing.SetUUID(ctx, uuid)
+
+		err := c.GetJSON(ctx, fmt.Sprintf("%s/%s", client.RoutersEP, uuid), nil, &router)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read router", err.Error())
+			return
+		}
+		if !hasAllTags(router.Tags, tags) || !hasAnyTag(router.Tags, tagsAny) {
+			resp.Diagnostics.AddError("No router found", fmt.Sprintf("Router %s does not match the given tags.", uuid))
+			return
+		}
+	} else {
+		routers, err := listAll(ctx, c, client.RoutersEP, tagQuery(tagQuery(nil, "tag", tags), "tag_any", tagsAny), func(r *models.RoutersListResponse) ([]models.Router, int) {
+			return r.Items, r.Total
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to list routers", err.Error())
+			return
+		}
+
+		var matches []models.Router
+		for _, item := range routers {
+			if !hasAllTags(item.Tags, tags) || !hasAnyTag(item.Tags, tagsAny) {
+				continue
+			}
+			if !matchString(config.Name, item.Name) {
+				continue
+			}
+			if nameRe != nil && !nameRe.MatchString(item.Name) {
+				continue
+			}
+			matches = append(matches, item)
+		}
+
+		var ok bool
+		router, ok = selectOne(matches, func(r models.Router) string { return r.CreatedAt }, config.MostRecent.ValueBool(), "router", &resp.Diagnostics)
+		if !ok {
+			return
+		}
+		tracing.SetUUID(ctx, router.RouterUUID)
 	}
 
 	config.ID = types.StringValue(router.RouterUUID)
@@ -92,6 +184,7 @@ func (d *routerDataSource) Read(ctx context.Context, req fwds.ReadRequest, resp
 	config.IPv6Address = types.StringValue(router.IPv6Address)
 	config.Status = types.StringValue(router.Status)
 	config.CreatedAt = types.StringValue(router.CreatedAt)
+	config.Tags = tagsValue(router.Tags)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
 }
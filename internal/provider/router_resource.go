@@ -5,15 +5,19 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/serverscamp/terraform-provider-scamp/internal/client"
 	"github.com/serverscamp/terraform-provider-scamp/internal/models"
+	"github.com/serverscamp/terraform-provider-scamp/internal/tracing"
 )
 
 type routerResource struct {
@@ -26,10 +30,11 @@ func (r *routerResource) Metadata(_ context.Context, _ tfresource.MetadataReques
 	resp.TypeName = "scamp_router"
 }
 
-func (r *routerResource) Schema(_ context.Context, _ tfresource.SchemaRequest, resp *tfresource.SchemaResponse) {
+func (r *routerResource) Schema(ctx context.Context, _ tfresource.SchemaRequest, resp *tfresource.SchemaResponse) {
 	resp.Schema = rschema.Schema{
 		Description: "Manages a router in SCAMP. Routers provide internet access for attached networks.",
 		Attributes: map[string]rschema.Attribute{
+			"region": regionAttribute(),
 			"id": rschema.StringAttribute{
 				Computed:    true,
 				Description: "The UUID of the router.",
@@ -41,6 +46,9 @@ func (r *routerResource) Schema(_ context.Context, _ tfresource.SchemaRequest, r
 				Optional:    true,
 				Computed:    true,
 				Description: "Name of the router (1-64 characters). Auto-generated if not provided.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -68,6 +76,9 @@ func (r *routerResource) Schema(_ context.Context, _ tfresource.SchemaRequest, r
 				ElementType: types.StringType,
 				Description: "Tags to assign to the router as key-value pairs.",
 			},
+			"tags_all":            tagsAllAttribute("router"),
+			"delete_on_failure":   deleteOnFailureAttribute("router"),
+			"deletion_protection": deletionProtectionAttribute("router"),
 			"status": rschema.StringAttribute{
 				Computed:    true,
 				Description: "Current status of the router (provision_queued, active, etc.).",
@@ -80,6 +91,9 @@ func (r *routerResource) Schema(_ context.Context, _ tfresource.SchemaRequest, r
 				},
 			},
 		},
+		Blocks: map[string]rschema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Delete: true}),
+		},
 	}
 }
 
@@ -91,14 +105,19 @@ func (r *routerResource) Configure(_ context.Context, req tfresource.ConfigureRe
 }
 
 type routerModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	IPv4Address types.String `tfsdk:"ipv4_address"`
-	IPv6Address types.String `tfsdk:"ipv6_address"`
-	Description types.String `tfsdk:"description"`
-	Tags        types.Map    `tfsdk:"tags"`
-	Status      types.String `tfsdk:"status"`
-	CreatedAt   types.String `tfsdk:"created_at"`
+	ID                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	IPv4Address        types.String   `tfsdk:"ipv4_address"`
+	IPv6Address        types.String   `tfsdk:"ipv6_address"`
+	Description        types.String   `tfsdk:"description"`
+	Tags               types.Map      `tfsdk:"tags"`
+	TagsAll            types.Map      `tfsdk:"tags_all"`
+	DeleteOnFailure    types.Bool     `tfsdk:"delete_on_failure"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	Status             types.String   `tfsdk:"status"`
+	CreatedAt          types.String   `tfsdk:"created_at"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+	Region             types.String   `tfsdk:"region"`
 }
 
 func (r *routerResource) setModelFromRouter(m *routerModel, rt *models.Router) {
@@ -107,23 +126,31 @@ func (r *routerResource) setModelFromRouter(m *routerModel, rt *models.Router) {
 	m.IPv4Address = types.StringValue(rt.IPv4Address)
 	m.IPv6Address = types.StringValue(rt.IPv6Address)
 	m.Status = types.StringValue(rt.Status)
+	m.Description = descriptionFromAPI(m.Description, rt.Description)
+	m.Tags = tagsFromAPI(m.Tags, rt.Tags, r.c.DefaultTags)
+	m.TagsAll = tagsValue(rt.Tags)
 	if rt.CreatedAt != "" {
 		m.CreatedAt = types.StringValue(rt.CreatedAt)
 	}
 }
 
-// waitForRouterActive polls until router status is "active" or timeout.
-func (r *routerResource) waitForRouterActive(ctx context.Context, uuid string, timeout time.Duration) (*models.Router, error) {
+// waitForRouterActive polls until router status is "active", a failed status or timeout.
+func waitForRouterActive(ctx context.Context, c *client.Client, uuid string, timeout time.Duration) (*models.Router, error) {
 	deadline := time.Now().Add(timeout)
-	for {
+	for attempt := 1; ; attempt++ {
 		var router models.Router
-		err := r.c.GetJSON(ctx, fmt.Sprintf("%s/%s", client.RoutersEP, uuid), nil, &router)
+		pollCtx, span := startPollSpan(ctx, "scamp_router", uuid, attempt)
+		err := c.GetJSON(pollCtx, fmt.Sprintf("%s/%s", client.RoutersEP, uuid), nil, &router)
+		endPollSpan(span, router.Status, err)
 		if err != nil {
 			return nil, err
 		}
 		if router.Status == "active" {
 			return &router, nil
 		}
+		if isFailedState(router.Status) {
+			return &router, fmt.Errorf("router %s failed to provision (status: %s)", uuid, router.Status)
+		}
 		if time.Now().After(deadline) {
 			return &router, fmt.Errorf("timeout waiting for router %s to become active (current status: %s)", uuid, router.Status)
 		}
@@ -132,95 +159,198 @@ func (r *routerResource) waitForRouterActive(ctx context.Context, uuid string, t
 	}
 }
 
+func (r *routerResource) ModifyPlan(ctx context.Context, req tfresource.ModifyPlanRequest, resp *tfresource.ModifyPlanResponse) {
+	checkDeletionProtection(ctx, req, resp, "router", "region")
+
+	// Nothing to plan on destroy or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.c == nil {
+		return
+	}
+	planTagsAll(ctx, r.c, req, resp)
+}
+
 func (r *routerResource) Create(ctx context.Context, req tfresource.CreateRequest, resp *tfresource.CreateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "scamp_router", "Create")
+	defer tracing.End(span, &resp.Diagnostics)
+
 	var plan routerModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	c := activeRegionalClient(ctx, r.c, plan.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+	plan.Region = types.StringValue(c.Region)
+
 	// Build payload
 	payload := map[string]any{}
 	if !plan.Name.IsNull() && plan.Name.ValueString() != "" {
 		payload["name"] = plan.Name.ValueString()
 	}
+	if !plan.Description.IsNull() {
+		payload["description"] = plan.Description.ValueString()
+	}
+	if tags := tagsPayload(ctx, plan.TagsAll, &resp.Diagnostics); len(tags) > 0 {
+		payload["tags"] = tags
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.DeletionProtection.ValueBool() {
+		// Server-side lock; also blocks deletion from the web console and API
+		payload["deletion_protection"] = true
+	}
 
 	// Create router
 	var router models.Router
-	if err := r.c.PostJSON(ctx, client.RoutersEP, payload, &router); err != nil {
+	if err := c.PostJSON(ctx, client.RoutersEP, payload, &router); err != nil {
 		resp.Diagnostics.AddError("Failed to create router", err.Error())
 		return
 	}
+	tracing.SetUUID(ctx, router.RouterUUID)
 
 	// Wait for router to become active
-	activeRouter, err := r.waitForRouterActive(ctx, router.RouterUUID, 2*time.Minute)
-	if err != nil {
-		resp.Diagnostics.AddWarning("Router created but not yet active", err.Error())
-		r.setModelFromRouter(&plan, &router)
-	} else {
+	activeRouter, err := waitForRouterActive(ctx, c, router.RouterUUID, 2*time.Minute)
+	if activeRouter != nil {
 		r.setModelFromRouter(&plan, activeRouter)
+	} else {
+		r.setModelFromRouter(&plan, &router)
+	}
+	if err != nil {
+		ep := fmt.Sprintf("%s/%s", client.RoutersEP, router.RouterUUID)
+		if !provisionFailed(ctx, &resp.Diagnostics, c, "scamp_router", "router", router.RouterUUID, ep, plan.DeleteOnFailure.ValueBool(), plan.Timeouts, err) {
+			saveTainted(ctx, &resp.State, &plan, &resp.Diagnostics)
+		}
+		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *routerResource) Read(ctx context.Context, req tfresource.ReadRequest, resp *tfresource.ReadResponse) {
+	ctx, span := tracing.StartOperation(ctx, "scamp_router", "Read")
+	defer tracing.End(span, &resp.Diagnostics)
+
 	var state routerModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	c := regionalClient(ctx, r.c, state.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+	state.Region = types.StringValue(c.Region)
+
 	uuid := state.ID.ValueString()
+	tracing.SetUUID(ctx, uuid)
 	if uuid == "" {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 
 	var router models.Router
-	err := r.c.GetJSON(ctx, fmt.Sprintf("%s/%s", client.RoutersEP, uuid), nil, &router)
-	if err != nil {
-		// Assume 404 - resource deleted
+	err := c.GetJSON(ctx, fmt.Sprintf("%s/%s", client.RoutersEP, uuid), nil, &router)
+	if client.IsNotFound(err) {
 		resp.State.RemoveResource(ctx)
 		return
 	}
-
-	// Save local-only fields before overwriting
-	savedDescription := state.Description
-	savedTags := state.Tags
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read router", err.Error())
+		return
+	}
 
 	r.setModelFromRouter(&state, &router)
 
-	// Restore local-only fields (not in API)
-	state.Description = savedDescription
-	state.Tags = savedTags
-
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *routerResource) Update(ctx context.Context, req tfresource.UpdateRequest, resp *tfresource.UpdateResponse) {
-	// Routers don't support updates via API - just preserve plan values
-	var plan routerModel
+	ctx, span := tracing.StartOperation(ctx, "scamp_router", "Update")
+	defer tracing.End(span, &resp.Diagnostics)
+
+	// Only description, tags and deletion protection can be updated via API
+	var plan, state routerModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	c := regionalClient(ctx, r.c, plan.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+
+	uuid := state.ID.ValueString()
+	tracing.SetUUID(ctx, uuid)
+
+	if !plan.Description.Equal(state.Description) || !plan.TagsAll.Equal(state.TagsAll) || !plan.DeletionProtection.Equal(state.DeletionProtection) {
+		payload := map[string]any{
+			"description":         plan.Description.ValueString(),
+			"tags":                tagsPayload(ctx, plan.TagsAll, &resp.Diagnostics),
+			"deletion_protection": plan.DeletionProtection.ValueBool(),
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := c.PatchJSON(ctx, fmt.Sprintf("%s/%s", client.RoutersEP, uuid), payload, nil); err != nil {
+			resp.Diagnostics.AddError("Failed to update router", err.Error())
+			return
+		}
+	}
+
+	var router models.Router
+	if err := c.GetJSON(ctx, fmt.Sprintf("%s/%s", client.RoutersEP, uuid), nil, &router); err != nil {
+		resp.Diagnostics.AddError("Failed to read router after update", err.Error())
+		return
+	}
+
+	// Routers can't be renamed via API; keep the planned name
+	savedName := plan.Name
+	r.setModelFromRouter(&plan, &router)
+	plan.Name = savedName
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *routerResource) Delete(ctx context.Context, req tfresource.DeleteRequest, resp *tfresource.DeleteResponse) {
+	ctx, span := tracing.StartOperation(ctx, "scamp_router", "Delete")
+	defer tracing.End(span, &resp.Diagnostics)
+
 	var state routerModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	timeout, diags := state.Timeouts.Delete(ctx, deleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := regionalClient(ctx, r.c, state.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+
 	uuid := state.ID.ValueString()
+	tracing.SetUUID(ctx, uuid)
 	if uuid == "" {
 		return
 	}
 
-	if err := r.c.Delete(ctx, fmt.Sprintf("%s/%s", client.RoutersEP, uuid)); err != nil {
+	if state.DeletionProtection.ValueBool() {
+		errDeletionProtected(resp, "router")
+		return
+	}
+
+	if err := deleteAndWait(ctx, c, "scamp_router", uuid, fmt.Sprintf("%s/%s", client.RoutersEP, uuid), timeout); err != nil {
 		resp.Diagnostics.AddError("Failed to delete router", err.Error())
 		return
 	}
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+
+	fwds "github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/serverscamp/terraform-provider-scamp/internal/client"
+	"github.com/serverscamp/terraform-provider-scamp/internal/models"
+	"github.com/serverscamp/terraform-provider-scamp/internal/tracing"
+)
+
+type routersDataSource struct {
+	c *client.Client
+}
+
+func NewRoutersDataSource() fwds.DataSource { return &routersDataSource{} }
+
+func (d *routersDataSource) Metadata(_ context.Context, _ fwds.MetadataRequest, resp *fwds.MetadataResponse) {
+	resp.TypeName = "scamp_routers"
+}
+
+func (d *routersDataSource) Schema(_ context.Context, _ fwds.SchemaRequest, resp *fwds.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Description: "Retrieves list of routers, optionally filtered.",
+		Attributes: map[string]dsschema.Attribute{
+			"region": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Region to read from. Defaults to the provider region.",
+			},
+			"items": dsschema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of routers.",
+				NestedObject: dsschema.NestedAttributeObject{
+					Attributes: map[string]dsschema.Attribute{
+						"id": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "UUID of the router.",
+						},
+						"name": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the router.",
+						},
+						"ipv4_address": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Public IPv4 address of the router.",
+						},
+						"ipv6_address": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Public IPv6 address of the router.",
+						},
+						"status": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Current status of the router.",
+						},
+						"tags": dsschema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Tags of the router.",
+						},
+						"created_at": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Timestamp when the router was created.",
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]dsschema.Block{
+			"filter": dsschema.SingleNestedBlock{
+				Description: "Only return routers matching all of the given criteria.",
+				Attributes: map[string]dsschema.Attribute{
+					"status": dsschema.StringAttribute{
+						Optional:    true,
+						Description: "Status of the router.",
+					},
+					"name_regex": dsschema.StringAttribute{
+						Optional:    true,
+						Description: "Regular expression matched against the router name.",
+					},
+					"tags": dsschema.MapAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "Only return routers having all of the given tags.",
+					},
+					"tags_any": dsschema.MapAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "Only return routers having at least one of the given tags.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *routersDataSource) Configure(_ context.Context, req fwds.ConfigureRequest, _ *fwds.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.c = req.ProviderData.(*client.Client)
+}
+
+type routerItemModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	IPv4Address types.String `tfsdk:"ipv4_address"`
+	IPv6Address types.String `tfsdk:"ipv6_address"`
+	Status      types.String `tfsdk:"status"`
+	Tags        types.Map    `tfsdk:"tags"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+type routersFilterModel struct {
+	Status    types.String `tfsdk:"status"`
+	NameRegex types.String `tfsdk:"name_regex"`
+	Tags      types.Map    `tfsdk:"tags"`
+	TagsAny   types.Map    `tfsdk:"tags_any"`
+}
+
+type routersDataSourceModel struct {
+	Filter *routersFilterModel `tfsdk:"filter"`
+	Items  []routerItemModel   `tfsdk:"items"`
+	Region types.String        `tfsdk:"region"`
+}
+
+func routerItemFromAPI(router models.Router) routerItemModel {
+	return routerItemModel{
+		ID:          types.StringValue(router.RouterUUID),
+		Name:        types.StringValue(router.Name),
+		IPv4Address: types.StringValue(router.IPv4Address),
+		IPv6Address: types.StringValue(router.IPv6Address),
+		Status:      types.StringValue(router.Status),
+		Tags:        tagsValue(router.Tags),
+		CreatedAt:   types.StringValue(router.CreatedAt),
+	}
+}
+
+func (d *routersDataSource) Read(ctx context.Context, req fwds.ReadRequest, resp *fwds.ReadResponse) {
+	ctx, span := tracing.StartDataSourceRead(ctx, "scamp_routers")
+	defer tracing.End(span, &resp.Diagnostics)
+
+	var state routersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := state.Filter
+	if filter == nil {
+		filter = &routersFilterModel{}
+	}
+	nameRe := compileNameRegex(filter.NameRegex, path.Root("filter").AtName("name_regex"), &resp.Diagnostics)
+	tags := tagsPayload(ctx, filter.Tags, &resp.Diagnostics)
+	tagsAny := tagsPayload(ctx, filter.TagsAny, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := regionalClient(ctx, d.c, state.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+
+	q := tagQuery(nil, "tag", tags)
+	q = tagQuery(q, "tag_any", tagsAny)
+	routers, err := listAll(ctx, c, client.RoutersEP, q, func(r *models.RoutersListResponse) ([]models.Router, int) {
+		return r.Items, r.Total
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list routers", err.Error())
+		return
+	}
+
+	state.Items = []routerItemModel{}
+	for _, router := range routers {
+		if !hasAllTags(router.Tags, tags) || !hasAnyTag(router.Tags, tagsAny) {
+			continue
+		}
+		if !matchString(filter.Status, router.Status) {
+			continue
+		}
+		if nameRe != nil && !nameRe.MatchString(router.Name) {
+			continue
+		}
+		state.Items = append(state.Items, routerItemFromAPI(router))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
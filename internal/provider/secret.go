@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// storeSecretAttribute controls whether a server-generated secret is saved in
+// state. The secret can always be read again through an ephemeral resource.
+func storeSecretAttribute(secret, ephemeral string) rschema.BoolAttribute {
+	return rschema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(true),
+		Description: fmt.Sprintf("Save %s in state (default: true). Set to false to keep it out of state "+
+			"and read it with the %s ephemeral resource when needed.", secret, ephemeral),
+	}
+}
+
+// planStoredSecret plans a secret as null when its store flag is false, so
+// turning the flag off also removes a value saved earlier. A secret set in
+// config always ends up in state and is rejected.
+func planStoredSecret(ctx context.Context, req tfresource.ModifyPlanRequest, resp *tfresource.ModifyPlanResponse, flag, secret string) {
+	var store types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(flag), &store)...)
+	if resp.Diagnostics.HasError() || store.IsUnknown() || store.ValueBool() {
+		return
+	}
+
+	var configured types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(secret), &configured)...)
+	if !configured.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root(secret), fmt.Sprintf("Conflicting %s", secret),
+			fmt.Sprintf("%s set in config is always saved in state, so it cannot be combined with %s = false.", secret, flag))
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(secret), types.StringNull())...)
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"golang.org/x/crypto/ssh"
+)
+
+type sshFingerprintFunction struct{}
+
+func NewSSHFingerprintFunction() function.Function { return &sshFingerprintFunction{} }
+
+func (f *sshFingerprintFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ssh_fingerprint"
+}
+
+func (f *sshFingerprintFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Compute the SHA256 fingerprint of an OpenSSH public key.",
+		Description: "Returns the fingerprint in the same format as the fingerprint attribute of scamp_ssh_key (e.g. SHA256:abc...).",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "public_key",
+				Description: "Public key in OpenSSH authorized_keys format.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *sshFingerprintFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var publicKey string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &publicKey))
+	if resp.Error != nil {
+		return
+	}
+
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(strings.TrimSpace(publicKey)))
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid OpenSSH public key: "+err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, ssh.FingerprintSHA256(key)))
+}
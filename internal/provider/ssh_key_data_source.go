@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	fwds "github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/serverscamp/terraform-provider-scamp/internal/client"
 	"github.com/serverscamp/terraform-provider-scamp/internal/models"
+	"github.com/serverscamp/terraform-provider-scamp/internal/tracing"
 )
 
 type sshKeyDataSource struct {
@@ -26,6 +28,10 @@ func (d *sshKeyDataSource) Schema(_ context.Context, _ fwds.SchemaRequest, resp
 	resp.Schema = dsschema.Schema{
 		Description: "Retrieves information about an existing SSH key by ID.",
 		Attributes: map[string]dsschema.Attribute{
+			"region": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Region to read from. Defaults to the provider region.",
+			},
 			"id": dsschema.Int64Attribute{
 				Required:    true,
 				Description: "The ID of the SSH key to retrieve.",
@@ -73,19 +79,29 @@ type sshKeyDataSourceModel struct {
 	Fingerprint   types.String `tfsdk:"fingerprint"`
 	HasPrivateKey types.Bool   `tfsdk:"has_private_key"`
 	CreatedAt     types.String `tfsdk:"created_at"`
+	Region        types.String `tfsdk:"region"`
 }
 
 func (d *sshKeyDataSource) Read(ctx context.Context, req fwds.ReadRequest, resp *fwds.ReadResponse) {
+	ctx, span := tracing.StartDataSourceRead(ctx, "scamp_ssh_key")
+	defer tracing.End(span, &resp.Diagnostics)
+
 	var config sshKeyDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	c := regionalClient(ctx, d.c, config.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+
 	id := config.ID.ValueInt64()
+	tracing.SetUUID(ctx, strconv.FormatInt(id, 10))
 
 	var key models.SSHKey
-	err := d.c.GetJSON(ctx, fmt.Sprintf("%s/%d", client.SSHKeysEP, id), nil, &key)
+	err := c.GetJSON(ctx, fmt.Sprintf("%s/%d", client.SSHKeysEP, id), nil, &key)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read SSH key", err.Error())
 		return
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	eschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/serverscamp/terraform-provider-scamp/internal/client"
+	"github.com/serverscamp/terraform-provider-scamp/internal/models"
+	"github.com/serverscamp/terraform-provider-scamp/internal/tracing"
+)
+
+type sshKeyPairEphemeralResource struct {
+	c *client.Client
+}
+
+func NewSSHKeyPairEphemeralResource() ephemeral.EphemeralResource {
+	return &sshKeyPairEphemeralResource{}
+}
+
+func (e *sshKeyPairEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "scamp_ssh_key_pair"
+}
+
+func (e *sshKeyPairEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = eschema.Schema{
+		Description: "Retrieves the private key of a server-generated SSH key without storing it in state or plan.",
+		Attributes: map[string]eschema.Attribute{
+			"id": eschema.Int64Attribute{
+				Required:    true,
+				Description: "ID of the SSH key. The key must have been generated by SCAMP (has_private_key = true).",
+			},
+			"region": eschema.StringAttribute{
+				Optional:    true,
+				Description: "Region to read from. Defaults to the provider region.",
+			},
+			"key_name": eschema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the SSH key.",
+			},
+			"key_type": eschema.StringAttribute{
+				Computed:    true,
+				Description: "Type of SSH key (ed25519, rsa, etc.).",
+			},
+			"public_key": eschema.StringAttribute{
+				Computed:    true,
+				Description: "Public key in OpenSSH format.",
+			},
+			"private_key": eschema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Private key in PEM format.",
+			},
+			"fingerprint": eschema.StringAttribute{
+				Computed:    true,
+				Description: "SHA256 fingerprint of the key.",
+			},
+		},
+	}
+}
+
+func (e *sshKeyPairEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, _ *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	e.c = req.ProviderData.(*client.Client)
+}
+
+type sshKeyPairEphemeralModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Region      types.String `tfsdk:"region"`
+	KeyName     types.String `tfsdk:"key_name"`
+	KeyType     types.String `tfsdk:"key_type"`
+	PublicKey   types.String `tfsdk:"public_key"`
+	PrivateKey  types.String `tfsdk:"private_key"`
+	Fingerprint types.String `tfsdk:"fingerprint"`
+}
+
+func (e *sshKeyPairEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	ctx, span := tracing.StartOperation(ctx, "scamp_ssh_key_pair", "Open")
+	defer tracing.End(span, &resp.Diagnostics)
+
+	var data sshKeyPairEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := regionalClient(ctx, e.c, data.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+
+	id := data.ID.ValueInt64()
+	tracing.SetUUID(ctx, strconv.FormatInt(id, 10))
+
+	var key models.SSHKey
+	if err := c.GetJSON(ctx, fmt.Sprintf("%s/%d", client.SSHKeysEP, id), nil, &key); err != nil {
+		resp.Diagnostics.AddError("Failed to read SSH key", err.Error())
+		return
+	}
+	if !key.HasPrivateKey {
+		resp.Diagnostics.AddError("SSH key has no private key",
+			fmt.Sprintf("SSH key %d was imported, so SCAMP does not store its private key. Only keys created with generate = true can be used here.", id))
+		return
+	}
+
+	var priv models.SSHKeyPrivateKey
+	if err := c.GetJSON(ctx, fmt.Sprintf("%s/%d/private-key", client.SSHKeysEP, id), nil, &priv); err != nil {
+		resp.Diagnostics.AddError("Failed to read SSH private key", err.Error())
+		return
+	}
+
+	data.KeyName = types.StringValue(key.KeyName)
+	data.KeyType = types.StringValue(key.KeyType)
+	data.PublicKey = types.StringValue(key.PublicKey)
+	data.PrivateKey = types.StringValue(priv.PrivateKey)
+	data.Fingerprint = types.StringValue(key.Fingerprint)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
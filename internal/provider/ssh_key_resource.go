@@ -3,18 +3,23 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/serverscamp/terraform-provider-scamp/internal/client"
 	"github.com/serverscamp/terraform-provider-scamp/internal/models"
+	"github.com/serverscamp/terraform-provider-scamp/internal/tracing"
 )
 
 type sshKeyResource struct {
@@ -27,10 +32,11 @@ func (r *sshKeyResource) Metadata(_ context.Context, _ tfresource.MetadataReques
 	resp.TypeName = "scamp_ssh_key"
 }
 
-func (r *sshKeyResource) Schema(_ context.Context, _ tfresource.SchemaRequest, resp *tfresource.SchemaResponse) {
+func (r *sshKeyResource) Schema(ctx context.Context, _ tfresource.SchemaRequest, resp *tfresource.SchemaResponse) {
 	resp.Schema = rschema.Schema{
 		Description: "Manages an SSH key in SCAMP. Supports both generating new keys and importing existing public keys.",
 		Attributes: map[string]rschema.Attribute{
+			"region": regionAttribute(),
 			"id": rschema.Int64Attribute{
 				Computed:    true,
 				Description: "The unique identifier of the SSH key.",
@@ -42,6 +48,9 @@ func (r *sshKeyResource) Schema(_ context.Context, _ tfresource.SchemaRequest, r
 				Optional:    true,
 				Computed:    true,
 				Description: "Name of the SSH key (max 255 chars). If not provided, auto-generated as key-{random}.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -57,6 +66,9 @@ func (r *sshKeyResource) Schema(_ context.Context, _ tfresource.SchemaRequest, r
 				Optional:    true,
 				Computed:    true,
 				Description: "Public key in OpenSSH format. Required for import, computed for generated keys.",
+				Validators: []validator.String{
+					openSSHPublicKeyValidator{},
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
@@ -70,6 +82,7 @@ func (r *sshKeyResource) Schema(_ context.Context, _ tfresource.SchemaRequest, r
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"store_private_key": storeSecretAttribute("private_key", "scamp_ssh_key_pair"),
 			"key_type": rschema.StringAttribute{
 				Computed:    true,
 				Description: "Type of SSH key (ed25519, rsa, ecdsa-sha2-nistp256, etc.).",
@@ -99,6 +112,9 @@ func (r *sshKeyResource) Schema(_ context.Context, _ tfresource.SchemaRequest, r
 				},
 			},
 		},
+		Blocks: map[string]rschema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Delete: true}),
+		},
 	}
 }
 
@@ -110,15 +126,18 @@ func (r *sshKeyResource) Configure(_ context.Context, req tfresource.ConfigureRe
 }
 
 type sshKeyModel struct {
-	ID            types.Int64  `tfsdk:"id"`
-	KeyName       types.String `tfsdk:"key_name"`
-	Generate      types.Bool   `tfsdk:"generate"`
-	PublicKey     types.String `tfsdk:"public_key"`
-	PrivateKey    types.String `tfsdk:"private_key"`
-	KeyType       types.String `tfsdk:"key_type"`
-	Fingerprint   types.String `tfsdk:"fingerprint"`
-	HasPrivateKey types.Bool   `tfsdk:"has_private_key"`
-	CreatedAt     types.String `tfsdk:"created_at"`
+	ID              types.Int64    `tfsdk:"id"`
+	KeyName         types.String   `tfsdk:"key_name"`
+	Generate        types.Bool     `tfsdk:"generate"`
+	PublicKey       types.String   `tfsdk:"public_key"`
+	PrivateKey      types.String   `tfsdk:"private_key"`
+	StorePrivateKey types.Bool     `tfsdk:"store_private_key"`
+	KeyType         types.String   `tfsdk:"key_type"`
+	Fingerprint     types.String   `tfsdk:"fingerprint"`
+	HasPrivateKey   types.Bool     `tfsdk:"has_private_key"`
+	CreatedAt       types.String   `tfsdk:"created_at"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+	Region          types.String   `tfsdk:"region"`
 }
 
 func (r *sshKeyResource) setModelFromKey(m *sshKeyModel, k *models.SSHKey) {
@@ -135,25 +154,37 @@ func (r *sshKeyResource) setModelFromKey(m *sshKeyModel, k *models.SSHKey) {
 	}
 }
 
+func (r *sshKeyResource) ConfigValidators(_ context.Context) []tfresource.ConfigValidator {
+	return []tfresource.ConfigValidator{
+		sshKeyModeValidator{},
+	}
+}
+
+func (r *sshKeyResource) ModifyPlan(ctx context.Context, req tfresource.ModifyPlanRequest, resp *tfresource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	planStoredSecret(ctx, req, resp, "store_private_key", "private_key")
+}
+
 func (r *sshKeyResource) Create(ctx context.Context, req tfresource.CreateRequest, resp *tfresource.CreateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "scamp_ssh_key", "Create")
+	defer tracing.End(span, &resp.Diagnostics)
+
 	var plan sshKeyModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	generate := !plan.Generate.IsNull() && plan.Generate.ValueBool()
-	hasPublicKey := !plan.PublicKey.IsNull() && plan.PublicKey.ValueString() != ""
-
-	// Validate: either generate or import, not both
-	if generate && hasPublicKey {
-		resp.Diagnostics.AddError("Invalid configuration", "Cannot set both 'generate = true' and 'public_key'. Choose one.")
-		return
-	}
-	if !generate && !hasPublicKey {
-		resp.Diagnostics.AddError("Invalid configuration", "Must set either 'generate = true' or provide 'public_key' for import.")
+	c := activeRegionalClient(ctx, r.c, plan.Region, &resp.Diagnostics)
+	if c == nil {
 		return
 	}
+	plan.Region = types.StringValue(c.Region)
+
+	// generate and public_key are mutually exclusive (see sshKeyModeValidator).
+	generate := !plan.Generate.IsNull() && plan.Generate.ValueBool()
 
 	keyName := plan.KeyName.ValueString()
 
@@ -165,13 +196,18 @@ func (r *sshKeyResource) Create(ctx context.Context, req tfresource.CreateReques
 		}
 
 		var key models.SSHKey
-		if err := r.c.PostJSON(ctx, client.SSHKeysEP+"/generate", payload, &key); err != nil {
+		if err := c.PostJSON(ctx, client.SSHKeysEP+"/generate", payload, &key); err != nil {
 			resp.Diagnostics.AddError("Failed to generate SSH key", err.Error())
 			return
 		}
 
 		r.setModelFromKey(&plan, &key)
+		tracing.SetUUID(ctx, strconv.Itoa(key.ID))
 		plan.Generate = types.BoolValue(true)
+		if !plan.StorePrivateKey.ValueBool() {
+			// Still readable through scamp_ssh_key_pair
+			plan.PrivateKey = types.StringNull()
+		}
 	} else {
 		// POST /ssh-keys/import
 		// Trim whitespace/newlines from public key (file() often includes trailing newline)
@@ -184,7 +220,7 @@ func (r *sshKeyResource) Create(ctx context.Context, req tfresource.CreateReques
 		}
 
 		var key models.SSHKey
-		if err := r.c.PostJSON(ctx, client.SSHKeysEP+"/import", payload, &key); err != nil {
+		if err := c.PostJSON(ctx, client.SSHKeysEP+"/import", payload, &key); err != nil {
 			resp.Diagnostics.AddError("Failed to import SSH key", err.Error())
 			return
 		}
@@ -192,6 +228,7 @@ func (r *sshKeyResource) Create(ctx context.Context, req tfresource.CreateReques
 		// Preserve the original public_key from plan (with possible trailing newline)
 		origPublicKey := plan.PublicKey
 		r.setModelFromKey(&plan, &key)
+		tracing.SetUUID(ctx, strconv.Itoa(key.ID))
 		plan.PublicKey = origPublicKey
 		// Keep generate as null (not false) to match plan
 		plan.PrivateKey = types.StringNull() // No private key for imported keys
@@ -201,25 +238,38 @@ func (r *sshKeyResource) Create(ctx context.Context, req tfresource.CreateReques
 }
 
 func (r *sshKeyResource) Read(ctx context.Context, req tfresource.ReadRequest, resp *tfresource.ReadResponse) {
+	ctx, span := tracing.StartOperation(ctx, "scamp_ssh_key", "Read")
+	defer tracing.End(span, &resp.Diagnostics)
+
 	var state sshKeyModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	c := regionalClient(ctx, r.c, state.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+	state.Region = types.StringValue(c.Region)
+
 	id := state.ID.ValueInt64()
+	tracing.SetUUID(ctx, strconv.FormatInt(id, 10))
 	if id <= 0 {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 
 	var key models.SSHKey
-	err := r.c.GetJSON(ctx, fmt.Sprintf("%s/%d", client.SSHKeysEP, id), nil, &key)
-	if err != nil {
-		// Check if 404 - resource was deleted externally
+	err := c.GetJSON(ctx, fmt.Sprintf("%s/%d", client.SSHKeysEP, id), nil, &key)
+	if client.IsNotFound(err) {
 		resp.State.RemoveResource(ctx)
 		return
 	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read SSH key", err.Error())
+		return
+	}
 
 	// Preserve generate, private_key, and public_key from state (not returned by API on read, or may differ in whitespace)
 	oldGenerate := state.Generate
@@ -239,29 +289,49 @@ func (r *sshKeyResource) Read(ctx context.Context, req tfresource.ReadRequest, r
 }
 
 func (r *sshKeyResource) Update(ctx context.Context, req tfresource.UpdateRequest, resp *tfresource.UpdateResponse) {
-	// SSH keys cannot be updated - all mutable attributes require replace
-	var state sshKeyModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	ctx, span := tracing.StartOperation(ctx, "scamp_ssh_key", "Update")
+	defer tracing.End(span, &resp.Diagnostics)
+
+	// SSH keys cannot be updated - all mutable attributes require replace.
+	// Only store_private_key changes in place; the plan already drops
+	// private_key when it is turned off.
+	var plan sshKeyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *sshKeyResource) Delete(ctx context.Context, req tfresource.DeleteRequest, resp *tfresource.DeleteResponse) {
+	ctx, span := tracing.StartOperation(ctx, "scamp_ssh_key", "Delete")
+	defer tracing.End(span, &resp.Diagnostics)
+
 	var state sshKeyModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	timeout, diags := state.Timeouts.Delete(ctx, deleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := regionalClient(ctx, r.c, state.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+
 	id := state.ID.ValueInt64()
+	tracing.SetUUID(ctx, strconv.FormatInt(id, 10))
 	if id <= 0 {
 		return
 	}
 
-	err := r.c.Delete(ctx, fmt.Sprintf("%s/%d", client.SSHKeysEP, id))
-	if err != nil {
+	ep := fmt.Sprintf("%s/%d", client.SSHKeysEP, id)
+	if err := deleteAndWait(ctx, c, "scamp_ssh_key", strconv.FormatInt(id, 10), ep, timeout); err != nil {
 		resp.Diagnostics.AddError("Failed to delete SSH key", err.Error())
 		return
 	}
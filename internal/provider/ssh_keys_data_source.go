@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+
+	fwds "github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/serverscamp/terraform-provider-scamp/internal/client"
+	"github.com/serverscamp/terraform-provider-scamp/internal/models"
+	"github.com/serverscamp/terraform-provider-scamp/internal/tracing"
+)
+
+type sshKeysDataSource struct {
+	c *client.Client
+}
+
+func NewSSHKeysDataSource() fwds.DataSource { return &sshKeysDataSource{} }
+
+func (d *sshKeysDataSource) Metadata(_ context.Context, _ fwds.MetadataRequest, resp *fwds.MetadataResponse) {
+	resp.TypeName = "scamp_ssh_keys"
+}
+
+func (d *sshKeysDataSource) Schema(_ context.Context, _ fwds.SchemaRequest, resp *fwds.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Description: "Retrieves list of SSH keys, optionally filtered.",
+		Attributes: map[string]dsschema.Attribute{
+			"region": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Region to read from. Defaults to the provider region.",
+			},
+			"items": dsschema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of SSH keys.",
+				NestedObject: dsschema.NestedAttributeObject{
+					Attributes: map[string]dsschema.Attribute{
+						"id": dsschema.Int64Attribute{
+							Computed:    true,
+							Description: "ID of the SSH key.",
+						},
+						"key_name": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the SSH key.",
+						},
+						"key_type": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Type of SSH key (ed25519, rsa, etc.).",
+						},
+						"public_key": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Public key in OpenSSH format.",
+						},
+						"fingerprint": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "SHA256 fingerprint of the key.",
+						},
+						"has_private_key": dsschema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the server stores the private key.",
+						},
+						"created_at": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Timestamp when the key was created.",
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]dsschema.Block{
+			"filter": dsschema.SingleNestedBlock{
+				Description: "Only return SSH keys matching all of the given criteria.",
+				Attributes: map[string]dsschema.Attribute{
+					"name_regex": dsschema.StringAttribute{
+						Optional:    true,
+						Description: "Regular expression matched against key_name.",
+					},
+					"key_type": dsschema.StringAttribute{
+						Optional:    true,
+						Description: "Type of SSH key (ed25519, rsa, etc.).",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *sshKeysDataSource) Configure(_ context.Context, req fwds.ConfigureRequest, _ *fwds.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.c = req.ProviderData.(*client.Client)
+}
+
+type sshKeyItemModel struct {
+	ID            types.Int64  `tfsdk:"id"`
+	KeyName       types.String `tfsdk:"key_name"`
+	KeyType       types.String `tfsdk:"key_type"`
+	PublicKey     types.String `tfsdk:"public_key"`
+	Fingerprint   types.String `tfsdk:"fingerprint"`
+	HasPrivateKey types.Bool   `tfsdk:"has_private_key"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+}
+
+type sshKeysFilterModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+	KeyType   types.String `tfsdk:"key_type"`
+}
+
+type sshKeysDataSourceModel struct {
+	Filter *sshKeysFilterModel `tfsdk:"filter"`
+	Items  []sshKeyItemModel   `tfsdk:"items"`
+	Region types.String        `tfsdk:"region"`
+}
+
+func (d *sshKeysDataSource) Read(ctx context.Context, req fwds.ReadRequest, resp *fwds.ReadResponse) {
+	ctx, span := tracing.StartDataSourceRead(ctx, "scamp_ssh_keys")
+	defer tracing.End(span, &resp.Diagnostics)
+
+	var state sshKeysDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := state.Filter
+	if filter == nil {
+		filter = &sshKeysFilterModel{}
+	}
+	nameRe := compileNameRegex(filter.NameRegex, path.Root("filter").AtName("name_regex"), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := regionalClient(ctx, d.c, state.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+
+	keys, err := listAll(ctx, c, client.SSHKeysEP, nil, func(r *models.SSHKeysListResponse) ([]models.SSHKey, int) {
+		return r.Items, r.Total
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list SSH keys", err.Error())
+		return
+	}
+
+	state.Items = []sshKeyItemModel{}
+	for _, key := range keys {
+		if !matchString(filter.KeyType, key.KeyType) {
+			continue
+		}
+		if nameRe != nil && !nameRe.MatchString(key.KeyName) {
+			continue
+		}
+		state.Items = append(state.Items, sshKeyItemModel{
+			ID:            types.Int64Value(int64(key.ID)),
+			KeyName:       types.StringValue(key.KeyName),
+			KeyType:       types.StringValue(key.KeyType),
+			PublicKey:     types.StringValue(key.PublicKey),
+			Fingerprint:   types.StringValue(key.Fingerprint),
+			HasPrivateKey: types.BoolValue(key.HasPrivateKey),
+			CreatedAt:     types.StringValue(key.CreatedAt),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
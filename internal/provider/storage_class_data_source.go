@@ -10,6 +10,7 @@ import (
 
 	"github.com/serverscamp/terraform-provider-scamp/internal/client"
 	"github.com/serverscamp/terraform-provider-scamp/internal/models"
+	"github.com/serverscamp/terraform-provider-scamp/internal/tracing"
 )
 
 type storageClassDataSource struct {
@@ -24,11 +25,48 @@ func (d *storageClassDataSource) Metadata(_ context.Context, _ fwds.MetadataRequ
 
 func (d *storageClassDataSource) Schema(_ context.Context, _ fwds.SchemaRequest, resp *fwds.SchemaResponse) {
 	resp.Schema = dsschema.Schema{
-		Description: "Find a storage class by name (e.g., 'SSD', 'HDD').",
+		Description: "Find a storage class by name (e.g., 'SSD', 'HDD'), or select the cheapest active class satisfying the given constraints.",
 		Attributes: map[string]dsschema.Attribute{
+			"region": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Region to read from. Defaults to the provider region.",
+			},
+			"include_inactive": dsschema.BoolAttribute{
+				Optional:    true,
+				Description: "Also include retired (inactive) storage classes.",
+			},
 			"name": dsschema.StringAttribute{
-				Required:    true,
-				Description: "Name of the storage class to search for.",
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the storage class to search for. Either name or at least one constraint must be set.",
+			},
+			"min_size_gb": dsschema.Int64Attribute{
+				Optional:    true,
+				Description: "Minimum value of max_size_gb, i.e. the class must allow disks of at least this size.",
+			},
+			"min_read_iops": dsschema.Int64Attribute{
+				Optional:    true,
+				Description: "Minimum read IOPS limit.",
+			},
+			"min_write_iops": dsschema.Int64Attribute{
+				Optional:    true,
+				Description: "Minimum write IOPS limit.",
+			},
+			"min_read_bandwidth": dsschema.Int64Attribute{
+				Optional:    true,
+				Description: "Minimum read bandwidth limit in MB/s.",
+			},
+			"min_write_bandwidth": dsschema.Int64Attribute{
+				Optional:    true,
+				Description: "Minimum write bandwidth limit in MB/s.",
+			},
+			"min_replica_count": dsschema.Int64Attribute{
+				Optional:    true,
+				Description: "Minimum number of data replicas.",
+			},
+			"max_price_per_gb_hour": dsschema.Float64Attribute{
+				Optional:    true,
+				Description: "Maximum price per GB per hour in millicents.",
 			},
 			"id": dsschema.Int64Attribute{
 				Computed:    true,
@@ -66,6 +104,10 @@ func (d *storageClassDataSource) Schema(_ context.Context, _ fwds.SchemaRequest,
 				Computed:    true,
 				Description: "Price per GB per hour in millicents.",
 			},
+			"is_active": dsschema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the storage class is active. Inactive entries are retired and cannot be used for new resources.",
+			},
 		},
 	}
 }
@@ -79,6 +121,13 @@ func (d *storageClassDataSource) Configure(_ context.Context, req fwds.Configure
 
 type storageClassDataSourceModel struct {
 	Name                     types.String  `tfsdk:"name"`
+	MinSizeGB                types.Int64   `tfsdk:"min_size_gb"`
+	MinReadIOPS              types.Int64   `tfsdk:"min_read_iops"`
+	MinWriteIOPS             types.Int64   `tfsdk:"min_write_iops"`
+	MinReadBandwidth         types.Int64   `tfsdk:"min_read_bandwidth"`
+	MinWriteBandwidth        types.Int64   `tfsdk:"min_write_bandwidth"`
+	MinReplicaCount          types.Int64   `tfsdk:"min_replica_count"`
+	MaxPricePerGBHour        types.Float64 `tfsdk:"max_price_per_gb_hour"`
 	ID                       types.Int64   `tfsdk:"id"`
 	Description              types.String  `tfsdk:"description"`
 	MaxSizeGB                types.Int64   `tfsdk:"max_size_gb"`
@@ -88,42 +137,79 @@ type storageClassDataSourceModel struct {
 	WriteBandwidthLimit      types.Int64   `tfsdk:"write_bandwidth_limit"`
 	ReplicaCount             types.Int64   `tfsdk:"replica_count"`
 	PricePerGBHourMillicents types.Float64 `tfsdk:"price_per_gb_hour_millicents"`
+	IncludeInactive          types.Bool    `tfsdk:"include_inactive"`
+	IsActive                 types.Bool    `tfsdk:"is_active"`
+	Region                   types.String  `tfsdk:"region"`
 }
 
 func (d *storageClassDataSource) Read(ctx context.Context, req fwds.ReadRequest, resp *fwds.ReadResponse) {
+	ctx, span := tracing.StartDataSourceRead(ctx, "scamp_storage_class")
+	defer tracing.End(span, &resp.Diagnostics)
+
 	var config storageClassDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	name := config.Name.ValueString()
+	if !anySet(config.Name, config.MinSizeGB, config.MinReadIOPS, config.MinWriteIOPS, config.MinReadBandwidth,
+		config.MinWriteBandwidth, config.MinReplicaCount, config.MaxPricePerGBHour) {
+		resp.Diagnostics.AddError("Invalid lookup", "Either name or at least one of min_size_gb, min_read_iops, min_write_iops, "+
+			"min_read_bandwidth, min_write_bandwidth, min_replica_count, max_price_per_gb_hour must be set.")
+		return
+	}
+
+	c := regionalClient(ctx, d.c, config.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
 
 	var listResp models.StorageClassesListResponse
-	err := d.c.GetJSON(ctx, client.StorageClassesEP, nil, &listResp)
+	err := c.GetJSON(ctx, client.StorageClassesEP, nil, &listResp)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read storage classes", err.Error())
 		return
 	}
 
+	var matches []models.StorageClass
 	for _, item := range listResp.Items {
-		if !item.IsActive {
+		if (!item.IsActive && !config.IncludeInactive.ValueBool()) || !matchString(config.Name, item.Name) {
 			continue
 		}
-		if item.Name == name {
-			config.ID = types.Int64Value(int64(item.ID))
-			config.Description = types.StringValue(item.Description)
-			config.MaxSizeGB = types.Int64Value(int64(item.MaxSizeGB))
-			config.ReadIOPSLimit = types.Int64Value(int64(item.ReadIOPSLimit))
-			config.WriteIOPSLimit = types.Int64Value(int64(item.WriteIOPSLimit))
-			config.ReadBandwidthLimit = types.Int64Value(int64(item.ReadBandwidthLimit))
-			config.WriteBandwidthLimit = types.Int64Value(int64(item.WriteBandwidthLimit))
-			config.ReplicaCount = types.Int64Value(int64(item.ReplicaCount))
-			config.PricePerGBHourMillicents = types.Float64Value(item.PricePerGBHourMillicents)
-			resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
-			return
+		if !atLeast(config.MinSizeGB, item.MaxSizeGB) ||
+			!atLeast(config.MinReadIOPS, item.ReadIOPSLimit) ||
+			!atLeast(config.MinWriteIOPS, item.WriteIOPSLimit) ||
+			!atLeast(config.MinReadBandwidth, item.ReadBandwidthLimit) ||
+			!atLeast(config.MinWriteBandwidth, item.WriteBandwidthLimit) ||
+			!atLeast(config.MinReplicaCount, item.ReplicaCount) ||
+			!atMost(config.MaxPricePerGBHour, item.PricePerGBHourMillicents) {
+			continue
 		}
+		matches = append(matches, item)
+	}
+
+	item, ok := cheapest(matches,
+		func(v models.StorageClass) float64 { return v.PricePerGBHourMillicents },
+		func(v models.StorageClass) int { return v.ID })
+	if !ok {
+		if !config.Name.IsNull() {
+			resp.Diagnostics.AddError("Storage class not found", fmt.Sprintf("No active storage class with name '%s' satisfies the given constraints", config.Name.ValueString()))
+		} else {
+			resp.Diagnostics.AddError("Storage class not found", "No active storage class satisfies the given constraints")
+		}
+		return
 	}
 
-	resp.Diagnostics.AddError("Storage class not found", fmt.Sprintf("No active storage class with name '%s'", name))
+	config.ID = types.Int64Value(int64(item.ID))
+	config.IsActive = types.BoolValue(item.IsActive)
+	config.Name = types.StringValue(item.Name)
+	config.Description = types.StringValue(item.Description)
+	config.MaxSizeGB = types.Int64Value(int64(item.MaxSizeGB))
+	config.ReadIOPSLimit = types.Int64Value(int64(item.ReadIOPSLimit))
+	config.WriteIOPSLimit = types.Int64Value(int64(item.WriteIOPSLimit))
+	config.ReadBandwidthLimit = types.Int64Value(int64(item.ReadBandwidthLimit))
+	config.WriteBandwidthLimit = types.Int64Value(int64(item.WriteBandwidthLimit))
+	config.ReplicaCount = types.Int64Value(int64(item.ReplicaCount))
+	config.PricePerGBHourMillicents = types.Float64Value(item.PricePerGBHourMillicents)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/serverscamp/terraform-provider-scamp/internal/client"
 	"github.com/serverscamp/terraform-provider-scamp/internal/models"
+	"github.com/serverscamp/terraform-provider-scamp/internal/tracing"
 )
 
 type storageClassesDataSource struct {
@@ -25,6 +26,14 @@ func (d *storageClassesDataSource) Schema(_ context.Context, _ fwds.SchemaReques
 	resp.Schema = dsschema.Schema{
 		Description: "Retrieves list of available storage classes (IOPS, bandwidth configurations).",
 		Attributes: map[string]dsschema.Attribute{
+			"region": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Region to read from. Defaults to the provider region.",
+			},
+			"include_inactive": dsschema.BoolAttribute{
+				Optional:    true,
+				Description: "Also include retired (inactive) storage classes.",
+			},
 			"items": dsschema.ListNestedAttribute{
 				Computed:    true,
 				Description: "List of storage classes.",
@@ -70,6 +79,10 @@ func (d *storageClassesDataSource) Schema(_ context.Context, _ fwds.SchemaReques
 							Computed:    true,
 							Description: "Price per GB per hour in millicents.",
 						},
+						"is_active": dsschema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the storage class is active. Inactive entries are retired and cannot be used for new resources.",
+						},
 					},
 				},
 			},
@@ -95,23 +108,39 @@ type storageClassModel struct {
 	WriteBandwidthLimit      types.Int64   `tfsdk:"write_bandwidth_limit"`
 	ReplicaCount             types.Int64   `tfsdk:"replica_count"`
 	PricePerGBHourMillicents types.Float64 `tfsdk:"price_per_gb_hour_millicents"`
+	IsActive                 types.Bool    `tfsdk:"is_active"`
 }
 
 type storageClassesDataSourceModel struct {
-	Items []storageClassModel `tfsdk:"items"`
+	IncludeInactive types.Bool          `tfsdk:"include_inactive"`
+	Items           []storageClassModel `tfsdk:"items"`
+	Region          types.String        `tfsdk:"region"`
 }
 
 func (d *storageClassesDataSource) Read(ctx context.Context, req fwds.ReadRequest, resp *fwds.ReadResponse) {
+	ctx, span := tracing.StartDataSourceRead(ctx, "scamp_storage_classes")
+	defer tracing.End(span, &resp.Diagnostics)
+
+	var state storageClassesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := regionalClient(ctx, d.c, state.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+
 	var listResp models.StorageClassesListResponse
-	err := d.c.GetJSON(ctx, client.StorageClassesEP, nil, &listResp)
+	err := c.GetJSON(ctx, client.StorageClassesEP, nil, &listResp)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read storage classes", err.Error())
 		return
 	}
 
-	var state storageClassesDataSourceModel
 	for _, item := range listResp.Items {
-		if !item.IsActive {
+		if !item.IsActive && !state.IncludeInactive.ValueBool() {
 			continue
 		}
 		state.Items = append(state.Items, storageClassModel{
@@ -125,6 +154,7 @@ func (d *storageClassesDataSource) Read(ctx context.Context, req fwds.ReadReques
 			WriteBandwidthLimit:      types.Int64Value(int64(item.WriteBandwidthLimit)),
 			ReplicaCount:             types.Int64Value(int64(item.ReplicaCount)),
 			PricePerGBHourMillicents: types.Float64Value(item.PricePerGBHourMillicents),
+			IsActive:                 types.BoolValue(item.IsActive),
 		})
 	}
 
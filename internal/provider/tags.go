@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/serverscamp/terraform-provider-scamp/internal/client"
+)
+
+// tagsValue converts API tags to a map value. Objects without tags get an
+// empty map.
+func tagsValue(tags map[string]string) types.Map {
+	elems := make(map[string]attr.Value, len(tags))
+	for k, v := range tags {
+		elems[k] = types.StringValue(v)
+	}
+	return types.MapValueMust(types.StringType, elems)
+}
+
+// tagsFromAPI returns the resource's own tags from the effective tags
+// reported by the API. Keys carrying the provider default value are left out
+// unless the resource sets them itself. An unset attribute stays null while
+// the object has no own tags, so omitting tags in config does not produce a
+// diff.
+func tagsFromAPI(current types.Map, tags, defaults map[string]string) types.Map {
+	configured := current.Elements()
+	own := make(map[string]string, len(tags))
+	for k, v := range tags {
+		if dv, ok := defaults[k]; ok && dv == v {
+			if _, set := configured[k]; !set {
+				continue
+			}
+		}
+		own[k] = v
+	}
+	if current.IsNull() && len(own) == 0 {
+		return current
+	}
+	return tagsValue(own)
+}
+
+// planTagsAll sets tags_all to the provider default tags merged with the
+// resource's tags, resource keys winning. It stays unknown while any tag
+// is unknown.
+func planTagsAll(ctx context.Context, c *client.Client, req tfresource.ModifyPlanRequest, resp *tfresource.ModifyPlanResponse) {
+	var tags types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tags"), &tags)...)
+	if resp.Diagnostics.HasError() || tags.IsUnknown() {
+		return
+	}
+	for _, v := range tags.Elements() {
+		if v.IsUnknown() {
+			return
+		}
+	}
+
+	all := make(map[string]string, len(c.DefaultTags))
+	for k, v := range c.DefaultTags {
+		all[k] = v
+	}
+	for k, v := range tagsPayload(ctx, tags, &resp.Diagnostics) {
+		all[k] = v
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsValue(all))...)
+}
+
+// tagsAllAttribute is shared by every taggable resource.
+func tagsAllAttribute(kind string) rschema.MapAttribute {
+	return rschema.MapAttribute{
+		Computed:    true,
+		ElementType: types.StringType,
+		Description: fmt.Sprintf("All tags of the %s, including the provider default_tags.", kind),
+	}
+}
+
+// descriptionFromAPI returns the description reported by the API. An unset
+// attribute stays null while the object has no description.
+func descriptionFromAPI(current types.String, description string) types.String {
+	if current.IsNull() && description == "" {
+		return current
+	}
+	return types.StringValue(description)
+}
+
+// tagsPayload converts a tags attribute for the API. Null tags become an
+// empty map, so removing tags from config clears them on update.
+func tagsPayload(ctx context.Context, m types.Map, diags *diag.Diagnostics) map[string]string {
+	tags := map[string]string{}
+	if m.IsNull() || m.IsUnknown() {
+		return tags
+	}
+	diags.Append(m.ElementsAs(ctx, &tags, false)...)
+	return tags
+}
+
+// tagQuery adds tag filters as repeated param=key:value query parameters so
+// list endpoints can filter server-side. Results are matched locally as well
+// (see hasAllTags), since not every endpoint honours them.
+func tagQuery(q url.Values, param string, tags map[string]string) url.Values {
+	if len(tags) == 0 {
+		return q
+	}
+	if q == nil {
+		q = url.Values{}
+	}
+	for k, v := range tags {
+		q.Add(param, k+":"+v)
+	}
+	return q
+}
+
+// hasAllTags reports whether tags contains every key/value pair of want.
+func hasAllTags(tags, want map[string]string) bool {
+	for k, v := range want {
+		if got, ok := tags[k]; !ok || got != v {
+			return false
+		}
+	}
+	return true
+}
+
+// hasAnyTag reports whether want is empty or tags contains at least one of
+// its key/value pairs.
+func hasAnyTag(tags, want map[string]string) bool {
+	if len(want) == 0 {
+		return true
+	}
+	for k, v := range want {
+		if got, ok := tags[k]; ok && got == v {
+			return true
+		}
+	}
+	return false
+}
+
+// tagFilterModel is the filter block of the singular data sources, shaped
+// like the tag criteria in the filter block of the plural ones.
+type tagFilterModel struct {
+	Tags    types.Map `tfsdk:"tags"`
+	TagsAny types.Map `tfsdk:"tags_any"`
+}
+
+// tagFilterBlock holds the tag criteria of the singular data sources. Matching
+// objects report their own tags in the computed tags attribute.
+func tagFilterBlock(kinds string) dsschema.SingleNestedBlock {
+	return dsschema.SingleNestedBlock{
+		Description: "Tag criteria. Can be used alone or together with another lookup argument.",
+		Attributes: map[string]dsschema.Attribute{
+			"tags": dsschema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: fmt.Sprintf("Only match %s having all of the given tags.", kinds),
+			},
+			"tags_any": dsschema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: fmt.Sprintf("Only match %s having at least one of the given tags.", kinds),
+			},
+		},
+	}
+}
+
+// tags returns the all-of and any-of tags of an optional filter block.
+func (f *tagFilterModel) tags(ctx context.Context, diags *diag.Diagnostics) (map[string]string, map[string]string) {
+	if f == nil {
+		return map[string]string{}, map[string]string{}
+	}
+	return tagsPayload(ctx, f.Tags, diags), tagsPayload(ctx, f.TagsAny, diags)
+}
@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/serverscamp/terraform-provider-scamp/internal/tracing"
+)
+
+// startPollSpan starts a child span for a single waiter poll.
+func startPollSpan(ctx context.Context, typeName, uuid string, attempt int) (context.Context, trace.Span) {
+	return tracing.Start(ctx, typeName+".wait",
+		tracing.ResourceTypeKey.String(typeName),
+		tracing.UUIDKey.String(uuid),
+		tracing.RetriesKey.Int(attempt-1),
+	)
+}
+
+// endPollSpan records the observed status and ends a poll span.
+func endPollSpan(span trace.Span, status string, err error) {
+	if status != "" {
+		span.SetAttributes(tracing.StatusKey.String(status))
+	}
+	tracing.EndErr(span, err)
+}
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/crypto/ssh"
+)
+
+// cidrValidator checks that a string is a CIDR block such as 10.50.0.0/24.
+type cidrValidator struct{}
+
+func (v cidrValidator) Description(_ context.Context) string {
+	return "value must be a CIDR block (e.g. 10.50.0.0/24)"
+}
+
+func (v cidrValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cidrValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, _, err := net.ParseCIDR(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid CIDR block", err.Error())
+	}
+}
+
+// rfc3339Validator checks that a string is an RFC 3339 timestamp such as
+// 2024-05-01T12:00:00Z.
+type rfc3339Validator struct{}
+
+func (v rfc3339Validator) Description(_ context.Context) string {
+	return "value must be an RFC 3339 timestamp (e.g. 2024-05-01T12:00:00Z)"
+}
+
+func (v rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rfc3339Validator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid timestamp", err.Error())
+	}
+}
+
+// openSSHPublicKeyValidator checks that a string is a public key in OpenSSH
+// authorized_keys format.
+type openSSHPublicKeyValidator struct{}
+
+func (v openSSHPublicKeyValidator) Description(_ context.Context) string {
+	return "value must be a public key in OpenSSH format"
+}
+
+func (v openSSHPublicKeyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v openSSHPublicKeyValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, _, _, _, err := ssh.ParseAuthorizedKey([]byte(strings.TrimSpace(req.ConfigValue.ValueString()))); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid OpenSSH public key", err.Error())
+	}
+}
+
+// networkRouterValidator requires router_uuid for public networks and
+// forbids it for private ones.
+type networkRouterValidator struct{}
+
+func (v networkRouterValidator) Description(_ context.Context) string {
+	return "router_uuid is required when type is 'public' and must not be set when type is 'private'"
+}
+
+func (v networkRouterValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v networkRouterValidator) ValidateResource(ctx context.Context, req tfresource.ValidateConfigRequest, resp *tfresource.ValidateConfigResponse) {
+	var networkType, routerUUID types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &networkType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("router_uuid"), &routerUUID)...)
+	if resp.Diagnostics.HasError() || networkType.IsNull() || networkType.IsUnknown() || routerUUID.IsUnknown() {
+		return
+	}
+
+	switch networkType.ValueString() {
+	case "public":
+		if routerUUID.IsNull() || routerUUID.ValueString() == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("router_uuid"), "Missing router_uuid", "router_uuid is required when type='public'")
+		}
+	case "private":
+		if !routerUUID.IsNull() && routerUUID.ValueString() != "" {
+			resp.Diagnostics.AddAttributeError(path.Root("router_uuid"), "Invalid configuration", "router_uuid must not be set when type='private'")
+		}
+	}
+}
+
+// sshKeyModeValidator requires exactly one of generate = true or public_key.
+type sshKeyModeValidator struct{}
+
+func (v sshKeyModeValidator) Description(_ context.Context) string {
+	return "exactly one of generate = true or public_key must be set"
+}
+
+func (v sshKeyModeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v sshKeyModeValidator) ValidateResource(ctx context.Context, req tfresource.ValidateConfigRequest, resp *tfresource.ValidateConfigResponse) {
+	var generate types.Bool
+	var publicKey types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("generate"), &generate)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("public_key"), &publicKey)...)
+	if resp.Diagnostics.HasError() || generate.IsUnknown() || publicKey.IsUnknown() {
+		return
+	}
+
+	generating := generate.ValueBool()
+	importing := !publicKey.IsNull() && publicKey.ValueString() != ""
+	switch {
+	case generating && importing:
+		resp.Diagnostics.AddAttributeError(path.Root("public_key"), "Invalid configuration",
+			"Cannot set both 'generate = true' and 'public_key'. Choose one.")
+	case !generating && !importing:
+		resp.Diagnostics.AddAttributeError(path.Root("generate"), "Invalid configuration",
+			"Must set either 'generate = true' or provide 'public_key' for import.")
+	}
+}
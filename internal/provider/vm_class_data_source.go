@@ -10,6 +10,7 @@ import (
 
 	"github.com/serverscamp/terraform-provider-scamp/internal/client"
 	"github.com/serverscamp/terraform-provider-scamp/internal/models"
+	"github.com/serverscamp/terraform-provider-scamp/internal/tracing"
 )
 
 type vmClassDataSource struct {
@@ -24,11 +25,32 @@ func (d *vmClassDataSource) Metadata(_ context.Context, _ fwds.MetadataRequest,
 
 func (d *vmClassDataSource) Schema(_ context.Context, _ fwds.SchemaRequest, resp *fwds.SchemaResponse) {
 	resp.Schema = dsschema.Schema{
-		Description: "Find a VM class by name (e.g., 'small', 'medium', 'large').",
+		Description: "Find a VM class by name (e.g., 'small', 'medium', 'large'), or select the cheapest active class satisfying the given constraints.",
 		Attributes: map[string]dsschema.Attribute{
+			"region": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Region to read from. Defaults to the provider region.",
+			},
+			"include_inactive": dsschema.BoolAttribute{
+				Optional:    true,
+				Description: "Also include retired (inactive) VM classes.",
+			},
 			"name": dsschema.StringAttribute{
-				Required:    true,
-				Description: "Name of the VM class to search for.",
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the VM class to search for. Either name or at least one constraint must be set.",
+			},
+			"min_cpu_cores": dsschema.Int64Attribute{
+				Optional:    true,
+				Description: "Minimum number of vCPU cores.",
+			},
+			"min_memory_mb": dsschema.Int64Attribute{
+				Optional:    true,
+				Description: "Minimum memory in MB.",
+			},
+			"max_price_per_hour": dsschema.Float64Attribute{
+				Optional:    true,
+				Description: "Maximum price per hour in millicents (10000 = 1 EUR).",
 			},
 			"id": dsschema.Int64Attribute{
 				Computed:    true,
@@ -58,6 +80,10 @@ func (d *vmClassDataSource) Schema(_ context.Context, _ fwds.SchemaRequest, resp
 				Computed:    true,
 				Description: "Price per hour in millicents (10000 = 1 EUR).",
 			},
+			"is_active": dsschema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the VM class is active. Inactive entries are retired and cannot be used for new resources.",
+			},
 		},
 	}
 }
@@ -71,6 +97,9 @@ func (d *vmClassDataSource) Configure(_ context.Context, req fwds.ConfigureReque
 
 type vmClassDataSourceModel struct {
 	Name                   types.String  `tfsdk:"name"`
+	MinCPUCores            types.Int64   `tfsdk:"min_cpu_cores"`
+	MinMemoryMB            types.Int64   `tfsdk:"min_memory_mb"`
+	MaxPricePerHour        types.Float64 `tfsdk:"max_price_per_hour"`
 	ID                     types.Int64   `tfsdk:"id"`
 	Description            types.String  `tfsdk:"description"`
 	CPUCores               types.Int64   `tfsdk:"cpu_cores"`
@@ -78,40 +107,71 @@ type vmClassDataSourceModel struct {
 	CPUMaxUsage            types.Int64   `tfsdk:"cpu_max_usage"`
 	MemoryMB               types.Int64   `tfsdk:"memory_mb"`
 	PricePerHourMillicents types.Float64 `tfsdk:"price_per_hour_millicents"`
+	IncludeInactive        types.Bool    `tfsdk:"include_inactive"`
+	IsActive               types.Bool    `tfsdk:"is_active"`
+	Region                 types.String  `tfsdk:"region"`
 }
 
 func (d *vmClassDataSource) Read(ctx context.Context, req fwds.ReadRequest, resp *fwds.ReadResponse) {
+	ctx, span := tracing.StartDataSourceRead(ctx, "scamp_vm_class")
+	defer tracing.End(span, &resp.Diagnostics)
+
 	var config vmClassDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	name := config.Name.ValueString()
+	if !anySet(config.Name, config.MinCPUCores, config.MinMemoryMB, config.MaxPricePerHour) {
+		resp.Diagnostics.AddError("Invalid lookup", "Either name or at least one of min_cpu_cores, min_memory_mb, max_price_per_hour must be set.")
+		return
+	}
+
+	c := regionalClient(ctx, d.c, config.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
 
 	var listResp models.VMClassesListResponse
-	err := d.c.GetJSON(ctx, client.VMClassesEP, nil, &listResp)
+	err := c.GetJSON(ctx, client.VMClassesEP, nil, &listResp)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read VM classes", err.Error())
 		return
 	}
 
+	var matches []models.VMClass
 	for _, item := range listResp.Items {
-		if !item.IsActive {
+		if (!item.IsActive && !config.IncludeInactive.ValueBool()) || !matchString(config.Name, item.Name) {
 			continue
 		}
-		if item.Name == name {
-			config.ID = types.Int64Value(int64(item.ID))
-			config.Description = types.StringValue(item.Description)
-			config.CPUCores = types.Int64Value(int64(item.CPUCores))
-			config.CPUMinUsage = types.Int64Value(int64(item.CPUMinUsage))
-			config.CPUMaxUsage = types.Int64Value(int64(item.CPUMaxUsage))
-			config.MemoryMB = types.Int64Value(int64(item.MemoryMB))
-			config.PricePerHourMillicents = types.Float64Value(item.PricePerHourMillicents)
-			resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
-			return
+		if !atLeast(config.MinCPUCores, item.CPUCores) ||
+			!atLeast(config.MinMemoryMB, item.MemoryMB) ||
+			!atMost(config.MaxPricePerHour, item.PricePerHourMillicents) {
+			continue
 		}
+		matches = append(matches, item)
+	}
+
+	item, ok := cheapest(matches,
+		func(v models.VMClass) float64 { return v.PricePerHourMillicents },
+		func(v models.VMClass) int { return v.ID })
+	if !ok {
+		if !config.Name.IsNull() {
+			resp.Diagnostics.AddError("VM class not found", fmt.Sprintf("No active VM class with name '%s' satisfies the given constraints", config.Name.ValueString()))
+		} else {
+			resp.Diagnostics.AddError("VM class not found", "No active VM class satisfies the given constraints")
+		}
+		return
 	}
 
-	resp.Diagnostics.AddError("VM class not found", fmt.Sprintf("No active VM class with name '%s'", name))
+	config.ID = types.Int64Value(int64(item.ID))
+	config.IsActive = types.BoolValue(item.IsActive)
+	config.Name = types.StringValue(item.Name)
+	config.Description = types.StringValue(item.Description)
+	config.CPUCores = types.Int64Value(int64(item.CPUCores))
+	config.CPUMinUsage = types.Int64Value(int64(item.CPUMinUsage))
+	config.CPUMaxUsage = types.Int64Value(int64(item.CPUMaxUsage))
+	config.MemoryMB = types.Int64Value(int64(item.MemoryMB))
+	config.PricePerHourMillicents = types.Float64Value(item.PricePerHourMillicents)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
 }
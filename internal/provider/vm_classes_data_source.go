@@ -9,6 +9,7 @@ import (
 
 	"github.com/serverscamp/terraform-provider-scamp/internal/client"
 	"github.com/serverscamp/terraform-provider-scamp/internal/models"
+	"github.com/serverscamp/terraform-provider-scamp/internal/tracing"
 )
 
 type vmClassesDataSource struct {
@@ -25,6 +26,14 @@ func (d *vmClassesDataSource) Schema(_ context.Context, _ fwds.SchemaRequest, re
 	resp.Schema = dsschema.Schema{
 		Description: "Retrieves list of available VM classes (CPU, memory configurations).",
 		Attributes: map[string]dsschema.Attribute{
+			"region": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Region to read from. Defaults to the provider region.",
+			},
+			"include_inactive": dsschema.BoolAttribute{
+				Optional:    true,
+				Description: "Also include retired (inactive) VM classes.",
+			},
 			"items": dsschema.ListNestedAttribute{
 				Computed:    true,
 				Description: "List of VM classes.",
@@ -62,6 +71,10 @@ func (d *vmClassesDataSource) Schema(_ context.Context, _ fwds.SchemaRequest, re
 							Computed:    true,
 							Description: "Price per hour in millicents (10000 = 1 EUR).",
 						},
+						"is_active": dsschema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the VM class is active. Inactive entries are retired and cannot be used for new resources.",
+						},
 					},
 				},
 			},
@@ -85,23 +98,39 @@ type vmClassModel struct {
 	CPUMaxUsage            types.Int64   `tfsdk:"cpu_max_usage"`
 	MemoryMB               types.Int64   `tfsdk:"memory_mb"`
 	PricePerHourMillicents types.Float64 `tfsdk:"price_per_hour_millicents"`
+	IsActive               types.Bool    `tfsdk:"is_active"`
 }
 
 type vmClassesDataSourceModel struct {
-	Items []vmClassModel `tfsdk:"items"`
+	IncludeInactive types.Bool     `tfsdk:"include_inactive"`
+	Items           []vmClassModel `tfsdk:"items"`
+	Region          types.String   `tfsdk:"region"`
 }
 
 func (d *vmClassesDataSource) Read(ctx context.Context, req fwds.ReadRequest, resp *fwds.ReadResponse) {
+	ctx, span := tracing.StartDataSourceRead(ctx, "scamp_vm_classes")
+	defer tracing.End(span, &resp.Diagnostics)
+
+	var state vmClassesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := regionalClient(ctx, d.c, state.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+
 	var listResp models.VMClassesListResponse
-	err := d.c.GetJSON(ctx, client.VMClassesEP, nil, &listResp)
+	err := c.GetJSON(ctx, client.VMClassesEP, nil, &listResp)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read VM classes", err.Error())
 		return
 	}
 
-	var state vmClassesDataSourceModel
 	for _, item := range listResp.Items {
-		if !item.IsActive {
+		if !item.IsActive && !state.IncludeInactive.ValueBool() {
 			continue
 		}
 		state.Items = append(state.Items, vmClassModel{
@@ -113,6 +142,7 @@ func (d *vmClassesDataSource) Read(ctx context.Context, req fwds.ReadRequest, re
 			CPUMaxUsage:            types.Int64Value(int64(item.CPUMaxUsage)),
 			MemoryMB:               types.Int64Value(int64(item.MemoryMB)),
 			PricePerHourMillicents: types.Float64Value(item.PricePerHourMillicents),
+			IsActive:               types.BoolValue(item.IsActive),
 		})
 	}
 
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	fwds "github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/serverscamp/terraform-provider-scamp/internal/client"
+	"github.com/serverscamp/terraform-provider-scamp/internal/models"
+	"github.com/serverscamp/terraform-provider-scamp/internal/tracing"
+)
+
+// consoleTailLines is how much console output is attached to VM create
+// failures.
+const consoleTailLines = 20
+
+type vmConsoleLogDataSource struct {
+	c *client.Client
+}
+
+func NewVMConsoleLogDataSource() fwds.DataSource { return &vmConsoleLogDataSource{} }
+
+func (d *vmConsoleLogDataSource) Metadata(_ context.Context, _ fwds.MetadataRequest, resp *fwds.MetadataResponse) {
+	resp.TypeName = "scamp_vm_console_log"
+}
+
+func (d *vmConsoleLogDataSource) Schema(_ context.Context, _ fwds.SchemaRequest, resp *fwds.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Description: "Retrieves the serial console output of a VM.",
+		Attributes: map[string]dsschema.Attribute{
+			"region": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Region to read from. Defaults to the provider region.",
+			},
+			"vm_id": dsschema.StringAttribute{
+				Required:    true,
+				Description: "UUID of the VM.",
+			},
+			"tail_lines": dsschema.Int64Attribute{
+				Optional:    true,
+				Description: "Return only the last N lines of output.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"since": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Return only output written after this RFC 3339 timestamp.",
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+			},
+			"output": dsschema.StringAttribute{
+				Computed:    true,
+				Description: "Console output.",
+			},
+			"lines": dsschema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Console output split into lines.",
+			},
+			"updated_at": dsschema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last console write.",
+			},
+		},
+	}
+}
+
+func (d *vmConsoleLogDataSource) Configure(_ context.Context, req fwds.ConfigureRequest, _ *fwds.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.c = req.ProviderData.(*client.Client)
+}
+
+type vmConsoleLogDataSourceModel struct {
+	VMID      types.String `tfsdk:"vm_id"`
+	TailLines types.Int64  `tfsdk:"tail_lines"`
+	Since     types.String `tfsdk:"since"`
+	Output    types.String `tfsdk:"output"`
+	Lines     types.List   `tfsdk:"lines"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+	Region    types.String `tfsdk:"region"`
+}
+
+// fetchConsoleLog returns the console output of a VM, split into lines. A
+// tail of 0 returns everything. The tail is also applied locally in case the
+// API returns more.
+func fetchConsoleLog(ctx context.Context, c *client.Client, uuid string, tail int, since string) (*models.VMConsoleLog, []string, error) {
+	q := url.Values{}
+	if tail > 0 {
+		q.Set("tail", strconv.Itoa(tail))
+	}
+	if since != "" {
+		q.Set("since", since)
+	}
+
+	var log models.VMConsoleLog
+	if err := c.GetJSON(ctx, fmt.Sprintf("%s/%s/console-log", client.VMsEP, uuid), q, &log); err != nil {
+		return nil, nil, err
+	}
+
+	lines := []string{}
+	if out := strings.TrimRight(log.Output, "\n"); out != "" {
+		lines = strings.Split(out, "\n")
+	}
+	if tail > 0 && len(lines) > tail {
+		lines = lines[len(lines)-tail:]
+	}
+	return &log, lines, nil
+}
+
+func (d *vmConsoleLogDataSource) Read(ctx context.Context, req fwds.ReadRequest, resp *fwds.ReadResponse) {
+	ctx, span := tracing.StartDataSourceRead(ctx, "scamp_vm_console_log")
+	defer tracing.End(span, &resp.Diagnostics)
+
+	var config vmConsoleLogDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := regionalClient(ctx, d.c, config.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+
+	uuid := config.VMID.ValueString()
+	tracing.SetUUID(ctx, uuid)
+
+	log, lines, err := fetchConsoleLog(ctx, c, uuid, int(config.TailLines.ValueInt64()), config.Since.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read VM console log", err.Error())
+		return
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.StringType, lines)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.Output = types.StringValue(strings.Join(lines, "\n"))
+	config.Lines = list
+	config.UpdatedAt = types.StringValue(log.UpdatedAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
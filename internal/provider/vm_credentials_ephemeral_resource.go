@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	eschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/serverscamp/terraform-provider-scamp/internal/client"
+	"github.com/serverscamp/terraform-provider-scamp/internal/models"
+	"github.com/serverscamp/terraform-provider-scamp/internal/tracing"
+)
+
+type vmCredentialsEphemeralResource struct {
+	c *client.Client
+}
+
+func NewVMCredentialsEphemeralResource() ephemeral.EphemeralResource {
+	return &vmCredentialsEphemeralResource{}
+}
+
+func (e *vmCredentialsEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "scamp_vm_credentials"
+}
+
+func (e *vmCredentialsEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = eschema.Schema{
+		Description: "Retrieves the OS credentials of a VM without storing them in state or plan.",
+		Attributes: map[string]eschema.Attribute{
+			"vm_id": eschema.StringAttribute{
+				Required:    true,
+				Description: "UUID of the VM.",
+			},
+			"region": eschema.StringAttribute{
+				Optional:    true,
+				Description: "Region to read from. Defaults to the provider region.",
+			},
+			"os_user": eschema.StringAttribute{
+				Computed:    true,
+				Description: "OS username.",
+			},
+			"os_password": eschema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "OS password.",
+			},
+		},
+	}
+}
+
+func (e *vmCredentialsEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, _ *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	e.c = req.ProviderData.(*client.Client)
+}
+
+type vmCredentialsEphemeralModel struct {
+	VMID       types.String `tfsdk:"vm_id"`
+	Region     types.String `tfsdk:"region"`
+	OSUser     types.String `tfsdk:"os_user"`
+	OSPassword types.String `tfsdk:"os_password"`
+}
+
+func (e *vmCredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	ctx, span := tracing.StartOperation(ctx, "scamp_vm_credentials", "Open")
+	defer tracing.End(span, &resp.Diagnostics)
+
+	var data vmCredentialsEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := regionalClient(ctx, e.c, data.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+
+	uuid := data.VMID.ValueString()
+	tracing.SetUUID(ctx, uuid)
+
+	var creds models.VMCredentials
+	if err := c.GetJSON(ctx, fmt.Sprintf("%s/%s/credentials", client.VMsEP, uuid), nil, &creds); err != nil {
+		resp.Diagnostics.AddError("Failed to read VM credentials", err.Error())
+		return
+	}
+
+	data.OSUser = types.StringValue(creds.OSUser)
+	data.OSPassword = types.StringValue(creds.OSPassword)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/serverscamp/terraform-provider-scamp/internal/client"
 	"github.com/serverscamp/terraform-provider-scamp/internal/models"
+	"github.com/serverscamp/terraform-provider-scamp/internal/tracing"
 )
 
 type vmDataSource struct {
@@ -24,20 +25,35 @@ func (d *vmDataSource) Metadata(_ context.Context, _ fwds.MetadataRequest, resp
 
 func (d *vmDataSource) Schema(_ context.Context, _ fwds.SchemaRequest, resp *fwds.SchemaResponse) {
 	resp.Schema = dsschema.Schema{
-		Description: "Retrieves information about an existing VM by UUID.",
+		Description: "Retrieves information about an existing VM by UUID, display name, system name or IP address.",
 		Attributes: map[string]dsschema.Attribute{
+			"region": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Region to read from. Defaults to the provider region.",
+			},
 			"id": dsschema.StringAttribute{
-				Required:    true,
-				Description: "The UUID of the VM to retrieve.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The UUID of the VM to retrieve. Exactly one of id, display_name, vm_name or ip_address must be set.",
 			},
 			"display_name": dsschema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
 				Description: "Display name of the VM.",
 			},
 			"vm_name": dsschema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
 				Description: "System name of the VM.",
 			},
+			"ip_address": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Internal or public (IPv4 or IPv6) address of the VM to retrieve.",
+			},
+			"most_recent": dsschema.BoolAttribute{
+				Optional:    true,
+				Description: "If several VMs match, use the most recently created one instead of failing.",
+			},
 			"vm_class_id": dsschema.Int64Attribute{
 				Computed:    true,
 				Description: "ID of the VM class.",
@@ -102,11 +118,19 @@ func (d *vmDataSource) Schema(_ context.Context, _ fwds.SchemaRequest, resp *fwd
 				Computed:    true,
 				Description: "Public IPv6 address.",
 			},
+			"tags": dsschema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Tags of the VM.",
+			},
 			"created_at": dsschema.StringAttribute{
 				Computed:    true,
 				Description: "Timestamp when the VM was created.",
 			},
 		},
+		Blocks: map[string]dsschema.Block{
+			"filter": tagFilterBlock("VMs"),
+		},
 	}
 }
 
@@ -118,44 +142,122 @@ func (d *vmDataSource) Configure(_ context.Context, req fwds.ConfigureRequest, _
 }
 
 type vmDataSourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	DisplayName      types.String `tfsdk:"display_name"`
-	VMName           types.String `tfsdk:"vm_name"`
-	VMClassID        types.Int64  `tfsdk:"vm_class_id"`
-	RootDiskClassID       types.Int64  `tfsdk:"root_disk_class_id"`
-	PrimaryNetworkClassID types.Int64  `tfsdk:"primary_network_class_id"`
-	VMTemplateID     types.Int64  `tfsdk:"vm_template_id"`
-	PrimaryNetworkID types.String `tfsdk:"primary_network_id"`
-	SSHKeyID         types.Int64  `tfsdk:"ssh_key_id"`
-	RootDiskGB       types.Int64  `tfsdk:"root_disk_gb"`
-	CPUCores         types.Int64  `tfsdk:"cpu_cores"`
-	MemoryMB         types.Int64  `tfsdk:"memory_mb"`
-	OSUser           types.String `tfsdk:"os_user"`
-	Status           types.String `tfsdk:"status"`
-	State            types.String `tfsdk:"state"`
-	IPInternal       types.String `tfsdk:"ip_internal"`
-	IPv6Address      types.String `tfsdk:"ipv6_address"`
-	PublicIPv4       types.String `tfsdk:"public_ip_v4"`
-	PublicIPv6       types.String `tfsdk:"public_ip_v6"`
-	CreatedAt        types.String `tfsdk:"created_at"`
+	ID                    types.String    `tfsdk:"id"`
+	DisplayName           types.String    `tfsdk:"display_name"`
+	VMName                types.String    `tfsdk:"vm_name"`
+	IPAddress             types.String    `tfsdk:"ip_address"`
+	MostRecent            types.Bool      `tfsdk:"most_recent"`
+	VMClassID             types.Int64     `tfsdk:"vm_class_id"`
+	RootDiskClassID       types.Int64     `tfsdk:"root_disk_class_id"`
+	PrimaryNetworkClassID types.Int64     `tfsdk:"primary_network_class_id"`
+	VMTemplateID          types.Int64     `tfsdk:"vm_template_id"`
+	PrimaryNetworkID      types.String    `tfsdk:"primary_network_id"`
+	SSHKeyID              types.Int64     `tfsdk:"ssh_key_id"`
+	RootDiskGB            types.Int64     `tfsdk:"root_disk_gb"`
+	CPUCores              types.Int64     `tfsdk:"cpu_cores"`
+	MemoryMB              types.Int64     `tfsdk:"memory_mb"`
+	OSUser                types.String    `tfsdk:"os_user"`
+	Status                types.String    `tfsdk:"status"`
+	State                 types.String    `tfsdk:"state"`
+	IPInternal            types.String    `tfsdk:"ip_internal"`
+	IPv6Address           types.String    `tfsdk:"ipv6_address"`
+	PublicIPv4            types.String    `tfsdk:"public_ip_v4"`
+	PublicIPv6            types.String    `tfsdk:"public_ip_v6"`
+	Tags                  types.Map       `tfsdk:"tags"`
+	CreatedAt             types.String    `tfsdk:"created_at"`
+	Filter                *tagFilterModel `tfsdk:"filter"`
+	Region                types.String    `tfsdk:"region"`
+}
+
+// vmHasIP reports whether ip is one of the internal or public addresses of vm.
+func vmHasIP(vm models.VM, ip string) bool {
+	if vm.Network == nil || ip == "" {
+		return false
+	}
+	for _, addr := range []string{vm.Network.IPInternal, vm.Network.IPv6Address, vm.Network.PublicIPv4, vm.Network.PublicIPv6} {
+		if addr == ip {
+			return true
+		}
+	}
+	return false
 }
 
 func (d *vmDataSource) Read(ctx context.Context, req fwds.ReadRequest, resp *fwds.ReadResponse) {
+	ctx, span := tracing.StartDataSourceRead(ctx, "scamp_vm")
+	defer tracing.End(span, &resp.Diagnostics)
+
 	var config vmDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	uuid := config.ID.ValueString()
+	// Tag filters narrow any lookup and can also be used on their own
+	tags, tagsAny := config.Filter.tags(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(tags) == 0 && len(tagsAny) == 0 || anySet(config.ID, config.DisplayName, config.VMName, config.IPAddress) {
+		if !checkLookupArgs(&resp.Diagnostics,
+			lookupArg{"id", !config.ID.IsNull()},
+			lookupArg{"display_name", !config.DisplayName.IsNull()},
+			lookupArg{"vm_name", !config.VMName.IsNull()},
+			lookupArg{"ip_address", !config.IPAddress.IsNull()},
+		) {
+			return
+		}
+	}
 
-	var vm models.VM
-	err := d.c.GetJSON(ctx, fmt.Sprintf("%s/%s", client.VMsEP, uuid), nil, &vm)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to read VM", err.Error())
+	c := regionalClient(ctx, d.c, config.Region, &resp.Diagnostics)
+	if c == nil {
 		return
 	}
 
+	var vm models.VM
+	if !config.ID.IsNull() {
+		uuid := config.ID.ValueString()
+		tracing.SetUUID(ctx, uuid)
+
+		err := c.GetJSON(ctx, fmt.Sprintf("%s/%s", client.VMsEP, uuid), nil, &vm)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read VM", err.Error())
+			return
+		}
+		if !hasAllTags(vm.Tags, tags) || !hasAnyTag(vm.Tags, tagsAny) {
+			resp.Diagnostics.AddError("No VM found", fmt.Sprintf("VM %s does not match the given tags.", uuid))
+			return
+		}
+	} else {
+		vms, err := listAll(ctx, c, client.VMsEP, tagQuery(tagQuery(nil, "tag", tags), "tag_any", tagsAny), func(r *models.VMsListResponse) ([]models.VM, int) {
+			return r.Items, r.Total
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to list VMs", err.Error())
+			return
+		}
+
+		var matches []models.VM
+		for _, item := range vms {
+			if !hasAllTags(item.Tags, tags) || !hasAnyTag(item.Tags, tagsAny) {
+				continue
+			}
+			if !matchString(config.DisplayName, item.DisplayName) || !matchString(config.VMName, item.VMName) {
+				continue
+			}
+			if !config.IPAddress.IsNull() && !vmHasIP(item, config.IPAddress.ValueString()) {
+				continue
+			}
+			matches = append(matches, item)
+		}
+
+		var ok bool
+		vm, ok = selectOne(matches, func(v models.VM) string { return v.CreatedAt }, config.MostRecent.ValueBool(), "VM", &resp.Diagnostics)
+		if !ok {
+			return
+		}
+		tracing.SetUUID(ctx, vm.VMUUID)
+	}
+
 	config.ID = types.StringValue(vm.VMUUID)
 	config.DisplayName = types.StringValue(vm.DisplayName)
 	config.VMName = types.StringValue(vm.VMName)
@@ -171,6 +273,7 @@ func (d *vmDataSource) Read(ctx context.Context, req fwds.ReadRequest, resp *fwd
 	config.Status = types.StringValue(vm.Status)
 	config.State = types.StringValue(vm.State)
 	config.CreatedAt = types.StringValue(vm.CreatedAt)
+	config.Tags = tagsValue(vm.Tags)
 
 	if vm.SSHKeyID != nil {
 		config.SSHKeyID = types.Int64Value(int64(*vm.SSHKeyID))
@@ -3,19 +3,27 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/serverscamp/terraform-provider-scamp/internal/client"
 	"github.com/serverscamp/terraform-provider-scamp/internal/models"
+	"github.com/serverscamp/terraform-provider-scamp/internal/tracing"
 )
 
 type vmResource struct {
@@ -28,10 +36,11 @@ func (r *vmResource) Metadata(_ context.Context, _ tfresource.MetadataRequest, r
 	resp.TypeName = "scamp_vm"
 }
 
-func (r *vmResource) Schema(_ context.Context, _ tfresource.SchemaRequest, resp *tfresource.SchemaResponse) {
+func (r *vmResource) Schema(ctx context.Context, _ tfresource.SchemaRequest, resp *tfresource.SchemaResponse) {
 	resp.Schema = rschema.Schema{
 		Description: "Manages a virtual machine in SCAMP.",
 		Attributes: map[string]rschema.Attribute{
+			"region": regionAttribute(),
 			"id": rschema.StringAttribute{
 				Computed:    true,
 				Description: "The UUID of the VM.",
@@ -43,6 +52,9 @@ func (r *vmResource) Schema(_ context.Context, _ tfresource.SchemaRequest, resp
 				Optional:    true,
 				Computed:    true,
 				Description: "Display name of the VM (max 100 characters).",
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(100),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -92,6 +104,9 @@ func (r *vmResource) Schema(_ context.Context, _ tfresource.SchemaRequest, resp
 			"root_disk_gb": rschema.Int64Attribute{
 				Required:    true,
 				Description: "Root disk size in GB (10-1000).",
+				Validators: []validator.Int64{
+					int64validator.Between(10, 1000),
+				},
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.RequiresReplace(),
 				},
@@ -100,30 +115,53 @@ func (r *vmResource) Schema(_ context.Context, _ tfresource.SchemaRequest, resp
 				Optional:    true,
 				Computed:    true,
 				Sensitive:   true,
-				Description: "OS password (8-64 characters). Auto-generated if not provided.",
+				Description: "OS password (8-64 characters). Auto-generated if not provided. Stored in state; prefer os_password_wo.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(8, 64),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
-			"assign_public_ips": rschema.BoolAttribute{
+			"store_os_password": storeSecretAttribute("os_password", "scamp_vm_credentials"),
+			"os_password_wo": rschema.StringAttribute{
 				Optional:    true,
-				Computed:    true,
-				Default:     booldefault.StaticBool(false),
-				Description: "Assign public IPv4/IPv6 addresses (default: false).",
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "Write-only OS password (8-64 characters), never stored in plan or state. Requires Terraform 1.11+. Conflicts with os_password.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(8, 64),
+				},
+			},
+			"os_password_wo_version": rschema.Int64Attribute{
+				Optional:    true,
+				Description: "Version of os_password_wo. Changing it resets the password of the running VM in place.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("os_password_wo")),
+				},
+			},
+			"assign_public_ips": rschema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				Default:       booldefault.StaticBool(false),
+				Description:   "Assign public IPv4/IPv6 addresses (default: false).",
 				PlanModifiers: []planmodifier.Bool{
 					// No RequiresReplace - could be changed in future
 				},
 			},
 			"description": rschema.StringAttribute{
 				Optional:    true,
-				Description: "Description of the VM (local only, not sent to API).",
+				Description: "Description of the VM.",
 			},
 			"tags": rschema.MapAttribute{
 				Optional:    true,
 				ElementType: types.StringType,
-				Description: "Tags for the VM as key-value pairs (local only, not sent to API).",
+				Description: "Tags for the VM as key-value pairs.",
 			},
+			"tags_all":            tagsAllAttribute("VM"),
+			"delete_on_failure":   deleteOnFailureAttribute("VM"),
+			"deletion_protection": deletionProtectionAttribute("VM"),
 			// Computed fields
 			"vm_name": rschema.StringAttribute{
 				Computed:    true,
@@ -191,6 +229,9 @@ func (r *vmResource) Schema(_ context.Context, _ tfresource.SchemaRequest, resp
 				},
 			},
 		},
+		Blocks: map[string]rschema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Delete: true}),
+		},
 	}
 }
 
@@ -202,31 +243,39 @@ func (r *vmResource) Configure(_ context.Context, req tfresource.ConfigureReques
 }
 
 type vmModel struct {
-	ID               types.String `tfsdk:"id"`
-	DisplayName      types.String `tfsdk:"display_name"`
-	VMClassID        types.Int64  `tfsdk:"vm_class_id"`
-	RootDiskClassID        types.Int64  `tfsdk:"root_disk_class_id"`
-	PrimaryNetworkClassID  types.Int64  `tfsdk:"primary_network_class_id"`
-	VMTemplateID     types.Int64  `tfsdk:"vm_template_id"`
-	PrimaryNetworkID types.String `tfsdk:"primary_network_id"`
-	SSHKeyID         types.Int64  `tfsdk:"ssh_key_id"`
-	RootDiskGB       types.Int64  `tfsdk:"root_disk_gb"`
-	OSPassword       types.String `tfsdk:"os_password"`
-	AssignPublicIPs  types.Bool   `tfsdk:"assign_public_ips"`
-	Description      types.String `tfsdk:"description"`
-	Tags             types.Map    `tfsdk:"tags"`
+	ID                    types.String `tfsdk:"id"`
+	DisplayName           types.String `tfsdk:"display_name"`
+	VMClassID             types.Int64  `tfsdk:"vm_class_id"`
+	RootDiskClassID       types.Int64  `tfsdk:"root_disk_class_id"`
+	PrimaryNetworkClassID types.Int64  `tfsdk:"primary_network_class_id"`
+	VMTemplateID          types.Int64  `tfsdk:"vm_template_id"`
+	PrimaryNetworkID      types.String `tfsdk:"primary_network_id"`
+	SSHKeyID              types.Int64  `tfsdk:"ssh_key_id"`
+	RootDiskGB            types.Int64  `tfsdk:"root_disk_gb"`
+	OSPassword            types.String `tfsdk:"os_password"`
+	StoreOSPassword       types.Bool   `tfsdk:"store_os_password"`
+	OSPasswordWO          types.String `tfsdk:"os_password_wo"`
+	OSPasswordWOVersion   types.Int64  `tfsdk:"os_password_wo_version"`
+	AssignPublicIPs       types.Bool   `tfsdk:"assign_public_ips"`
+	Description           types.String `tfsdk:"description"`
+	Tags                  types.Map    `tfsdk:"tags"`
+	TagsAll               types.Map    `tfsdk:"tags_all"`
+	DeleteOnFailure       types.Bool   `tfsdk:"delete_on_failure"`
+	DeletionProtection    types.Bool   `tfsdk:"deletion_protection"`
 	// Computed
-	VMName      types.String `tfsdk:"vm_name"`
-	CPUCores    types.Int64  `tfsdk:"cpu_cores"`
-	MemoryMB    types.Int64  `tfsdk:"memory_mb"`
-	OSUser      types.String `tfsdk:"os_user"`
-	Status      types.String `tfsdk:"status"`
-	State       types.String `tfsdk:"state"`
-	IPInternal  types.String `tfsdk:"ip_internal"`
-	IPv6Address types.String `tfsdk:"ipv6_address"`
-	PublicIPv4  types.String `tfsdk:"public_ip_v4"`
-	PublicIPv6  types.String `tfsdk:"public_ip_v6"`
-	CreatedAt   types.String `tfsdk:"created_at"`
+	VMName      types.String   `tfsdk:"vm_name"`
+	CPUCores    types.Int64    `tfsdk:"cpu_cores"`
+	MemoryMB    types.Int64    `tfsdk:"memory_mb"`
+	OSUser      types.String   `tfsdk:"os_user"`
+	Status      types.String   `tfsdk:"status"`
+	State       types.String   `tfsdk:"state"`
+	IPInternal  types.String   `tfsdk:"ip_internal"`
+	IPv6Address types.String   `tfsdk:"ipv6_address"`
+	PublicIPv4  types.String   `tfsdk:"public_ip_v4"`
+	PublicIPv6  types.String   `tfsdk:"public_ip_v6"`
+	CreatedAt   types.String   `tfsdk:"created_at"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+	Region      types.String   `tfsdk:"region"`
 }
 
 func (r *vmResource) setModelFromVM(m *vmModel, vm *models.VM) {
@@ -244,6 +293,9 @@ func (r *vmResource) setModelFromVM(m *vmModel, vm *models.VM) {
 	m.OSUser = types.StringValue(vm.OSUser)
 	m.Status = types.StringValue(vm.Status)
 	m.State = types.StringValue(vm.State)
+	m.Description = descriptionFromAPI(m.Description, vm.Description)
+	m.Tags = tagsFromAPI(m.Tags, vm.Tags, r.c.DefaultTags)
+	m.TagsAll = tagsValue(vm.Tags)
 	if vm.CreatedAt != "" {
 		m.CreatedAt = types.StringValue(vm.CreatedAt)
 	}
@@ -262,19 +314,24 @@ func (r *vmResource) setModelFromVM(m *vmModel, vm *models.VM) {
 	}
 }
 
-func (r *vmResource) waitForVMRunning(ctx context.Context, uuid string, timeout time.Duration) (*models.VM, error) {
+func waitForVMRunning(ctx context.Context, c *client.Client, uuid string, timeout time.Duration) (*models.VM, error) {
 	deadline := time.Now().Add(timeout)
-	for {
+	for attempt := 1; ; attempt++ {
 		var vm models.VM
-		err := r.c.GetJSON(ctx, fmt.Sprintf("%s/%s", client.VMsEP, uuid), nil, &vm)
+		pollCtx, span := startPollSpan(ctx, "scamp_vm", uuid, attempt)
+		err := c.GetJSON(pollCtx, fmt.Sprintf("%s/%s", client.VMsEP, uuid), nil, &vm)
+		endPollSpan(span, vm.State, err)
 		if err != nil {
 			return nil, err
 		}
 		if vm.State == "running" {
 			return &vm, nil
 		}
+		if isFailedState(vm.State) || isFailedState(vm.Status) {
+			return &vm, fmt.Errorf("VM %s failed to start (status: %s, state: %s)", uuid, vm.Status, vm.State)
+		}
 		if time.Now().After(deadline) {
-			return &vm, fmt.Errorf("timeout waiting for VM %s to start (state: %s)", uuid, vm.State)
+			return &vm, fmt.Errorf("timeout waiting for VM %s to start (status: %s, state: %s)", uuid, vm.Status, vm.State)
 		}
 		tflog.Debug(ctx, "Waiting for VM to start", map[string]any{
 			"uuid":  uuid,
@@ -284,13 +341,117 @@ func (r *vmResource) waitForVMRunning(ctx context.Context, uuid string, timeout
 	}
 }
 
+func (r *vmResource) ConfigValidators(_ context.Context) []tfresource.ConfigValidator {
+	return []tfresource.ConfigValidator{
+		resourcevalidator.Conflicting(path.MatchRoot("os_password"), path.MatchRoot("os_password_wo")),
+	}
+}
+
+func (r *vmResource) ModifyPlan(ctx context.Context, req tfresource.ModifyPlanRequest, resp *tfresource.ModifyPlanResponse) {
+	checkDeletionProtection(ctx, req, resp, "VM", "vm_class_id", "root_disk_class_id", "root_disk_gb",
+		"primary_network_class_id", "primary_network_id", "vm_template_id", "ssh_key_id", "os_password", "region")
+
+	// Nothing to check on destroy or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.c == nil {
+		return
+	}
+
+	planTagsAll(ctx, r.c, req, resp)
+	planStoredSecret(ctx, req, resp, "store_os_password", "os_password")
+
+	var plan vmModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Catalog references only need to be valid for VMs that will be
+	// (re)created; all of them force replacement.
+	var state *vmModel
+	if !req.State.Raw.IsNull() {
+		state = &vmModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	replacing := state == nil ||
+		!plan.VMClassID.Equal(state.VMClassID) ||
+		!plan.RootDiskClassID.Equal(state.RootDiskClassID) ||
+		!plan.RootDiskGB.Equal(state.RootDiskGB) ||
+		!plan.PrimaryNetworkClassID.Equal(state.PrimaryNetworkClassID) ||
+		!plan.VMTemplateID.Equal(state.VMTemplateID) ||
+		!plan.Region.Equal(state.Region)
+
+	c := regionalClient(ctx, r.c, plan.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+
+	cat, err := fetchCatalog(ctx, c)
+	if err != nil {
+		tflog.Warn(ctx, "Skipping catalog checks", map[string]any{"error": err.Error()})
+		return
+	}
+
+	if id, ok := knownID(plan.VMClassID); ok {
+		if vmClass, found := cat.vmClasses[id]; found {
+			warnInactive(&resp.Diagnostics, "vm_class_id", "VM class", vmClass.Name, id, vmClass.IsActive)
+			if plan.CPUCores.IsUnknown() {
+				resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("cpu_cores"), types.Int64Value(int64(vmClass.CPUCores)))...)
+			}
+			if plan.MemoryMB.IsUnknown() {
+				resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("memory_mb"), types.Int64Value(int64(vmClass.MemoryMB)))...)
+			}
+		} else if replacing {
+			errNotInCatalog(&resp.Diagnostics, "vm_class_id", "VM class", id)
+		}
+	}
+
+	if id, ok := knownID(plan.RootDiskClassID); ok {
+		if storageClass, found := cat.storageClasses[id]; found {
+			warnInactive(&resp.Diagnostics, "root_disk_class_id", "storage class", storageClass.Name, id, storageClass.IsActive)
+			if replacing {
+				checkDiskSize(&resp.Diagnostics, "root_disk_gb", plan.RootDiskGB, storageClass)
+			}
+		} else if replacing {
+			errNotInCatalog(&resp.Diagnostics, "root_disk_class_id", "storage class", id)
+		}
+	}
+
+	if id, ok := knownID(plan.PrimaryNetworkClassID); ok {
+		if networkClass, found := cat.networkClasses[id]; found {
+			warnInactive(&resp.Diagnostics, "primary_network_class_id", "network class", networkClass.Name, id, networkClass.IsActive)
+		} else if replacing {
+			errNotInCatalog(&resp.Diagnostics, "primary_network_class_id", "network class", id)
+		}
+	}
+
+	if id, ok := knownID(plan.VMTemplateID); ok {
+		if template, found := cat.templates[id]; found {
+			warnInactive(&resp.Diagnostics, "vm_template_id", "VM template", template.Name, id, template.IsActive)
+		} else if replacing {
+			errNotInCatalog(&resp.Diagnostics, "vm_template_id", "VM template", id)
+		}
+	}
+}
+
 func (r *vmResource) Create(ctx context.Context, req tfresource.CreateRequest, resp *tfresource.CreateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "scamp_vm", "Create")
+	defer tracing.End(span, &resp.Diagnostics)
+
 	var plan vmModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	c := activeRegionalClient(ctx, r.c, plan.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+	plan.Region = types.StringValue(c.Region)
+
 	payload := map[string]any{
 		"vm_class_id":      plan.VMClassID.ValueInt64(),
 		"storage_class_id": plan.RootDiskClassID.ValueInt64(),
@@ -306,60 +467,113 @@ func (r *vmResource) Create(ctx context.Context, req tfresource.CreateRequest, r
 	if !plan.SSHKeyID.IsNull() {
 		payload["ssh_key_id"] = plan.SSHKeyID.ValueInt64()
 	}
-	if !plan.OSPassword.IsNull() && plan.OSPassword.ValueString() != "" {
+	if !plan.OSPassword.IsNull() && !plan.OSPassword.IsUnknown() && plan.OSPassword.ValueString() != "" {
 		payload["os_password"] = plan.OSPassword.ValueString()
 	}
+
+	// Write-only values are only available in config
+	var passwordWO types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("os_password_wo"), &passwordWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !passwordWO.IsNull() && passwordWO.ValueString() != "" {
+		payload["os_password"] = passwordWO.ValueString()
+	}
 	if !plan.AssignPublicIPs.IsNull() && plan.AssignPublicIPs.ValueBool() {
 		payload["assign_public_ips"] = true
 	}
+	if !plan.Description.IsNull() {
+		payload["description"] = plan.Description.ValueString()
+	}
+	if tags := tagsPayload(ctx, plan.TagsAll, &resp.Diagnostics); len(tags) > 0 {
+		payload["tags"] = tags
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.DeletionProtection.ValueBool() {
+		// Server-side lock; also blocks deletion from the web console and API
+		payload["deletion_protection"] = true
+	}
 
 	var createResp models.VMCreateResponse
-	if err := r.c.PostJSON(ctx, client.VMsEP, payload, &createResp); err != nil {
+	if err := c.PostJSON(ctx, client.VMsEP, payload, &createResp); err != nil {
 		resp.Diagnostics.AddError("Failed to create VM", err.Error())
 		return
 	}
 
 	// Save initial data from create response
 	plan.ID = types.StringValue(createResp.VMUUID)
+	tracing.SetUUID(ctx, createResp.VMUUID)
 	plan.VMName = types.StringValue(createResp.VMName)
 	plan.OSUser = types.StringValue(createResp.OSUser)
 	plan.OSPassword = types.StringValue(createResp.OSPassword)
+	if !passwordWO.IsNull() || !plan.StoreOSPassword.ValueBool() {
+		// Never persist the write-only password; a generated one is only
+		// kept when store_os_password is set
+		plan.OSPassword = types.StringNull()
+	}
 	plan.Status = types.StringValue(createResp.Status)
 
 	// Wait for VM to start running
-	activeVM, err := r.waitForVMRunning(ctx, createResp.VMUUID, 5*time.Minute)
-	if err != nil {
-		resp.Diagnostics.AddWarning("VM created but not yet active", err.Error())
-	} else {
+	activeVM, err := waitForVMRunning(ctx, c, createResp.VMUUID, 5*time.Minute)
+	if activeVM != nil {
 		// Preserve os_password from create response (it's not returned in GET)
 		savedPassword := plan.OSPassword
 		r.setModelFromVM(&plan, activeVM)
 		plan.OSPassword = savedPassword
 	}
+	if err != nil {
+		// Boot output usually explains why a VM hangs or fails to start
+		if _, lines, logErr := fetchConsoleLog(ctx, c, createResp.VMUUID, consoleTailLines, ""); logErr != nil {
+			tflog.Debug(ctx, "Could not read console log", map[string]any{"error": logErr.Error()})
+		} else if len(lines) > 0 {
+			err = fmt.Errorf("%w\n\nLast %d lines of console output:\n%s", err, len(lines), strings.Join(lines, "\n"))
+		}
+		ep := fmt.Sprintf("%s/%s", client.VMsEP, createResp.VMUUID)
+		if !provisionFailed(ctx, &resp.Diagnostics, c, "scamp_vm", "VM", createResp.VMUUID, ep, plan.DeleteOnFailure.ValueBool(), plan.Timeouts, err) {
+			saveTainted(ctx, &resp.State, &plan, &resp.Diagnostics)
+		}
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *vmResource) Read(ctx context.Context, req tfresource.ReadRequest, resp *tfresource.ReadResponse) {
+	ctx, span := tracing.StartOperation(ctx, "scamp_vm", "Read")
+	defer tracing.End(span, &resp.Diagnostics)
+
 	var state vmModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	c := regionalClient(ctx, r.c, state.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+	state.Region = types.StringValue(c.Region)
+
 	uuid := state.ID.ValueString()
+	tracing.SetUUID(ctx, uuid)
 	if uuid == "" {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 
 	var vm models.VM
-	err := r.c.GetJSON(ctx, fmt.Sprintf("%s/%s", client.VMsEP, uuid), nil, &vm)
-	if err != nil {
-		// Assume 404 - resource deleted
+	err := c.GetJSON(ctx, fmt.Sprintf("%s/%s", client.VMsEP, uuid), nil, &vm)
+	if client.IsNotFound(err) {
 		resp.State.RemoveResource(ctx)
 		return
 	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read VM", err.Error())
+		return
+	}
 
 	// Preserve fields not returned by API
 	savedPassword := state.OSPassword
@@ -375,28 +589,118 @@ func (r *vmResource) Read(ctx context.Context, req tfresource.ReadRequest, resp
 }
 
 func (r *vmResource) Update(ctx context.Context, req tfresource.UpdateRequest, resp *tfresource.UpdateResponse) {
-	// VM doesn't support updates - all changes require replace
-	var plan vmModel
+	ctx, span := tracing.StartOperation(ctx, "scamp_vm", "Update")
+	defer tracing.End(span, &resp.Diagnostics)
+
+	// Most changes require replace; password rotation, store_os_password,
+	// description, tags and deletion protection are updated in place
+	var plan, state vmModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	c := regionalClient(ctx, r.c, plan.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+
+	uuid := state.ID.ValueString()
+	tracing.SetUUID(ctx, uuid)
+
+	// Reset password when os_password_wo_version changes
+	if !plan.OSPasswordWOVersion.Equal(state.OSPasswordWOVersion) && !plan.OSPasswordWOVersion.IsNull() {
+		var passwordWO types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("os_password_wo"), &passwordWO)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if passwordWO.IsNull() || passwordWO.ValueString() == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("os_password_wo"), "Missing os_password_wo",
+				"os_password_wo must be set when os_password_wo_version changes.")
+			return
+		}
+		payload := map[string]any{
+			"os_password": passwordWO.ValueString(),
+		}
+		if err := c.PostJSON(ctx, fmt.Sprintf("%s/%s/reset-password", client.VMsEP, uuid), payload, nil); err != nil {
+			resp.Diagnostics.AddError("Failed to reset VM password", err.Error())
+			return
+		}
+		tflog.Info(ctx, "Reset VM password", map[string]any{"uuid": uuid, "version": plan.OSPasswordWOVersion.ValueInt64()})
+	}
+
+	if !plan.Description.Equal(state.Description) || !plan.TagsAll.Equal(state.TagsAll) || !plan.DeletionProtection.Equal(state.DeletionProtection) {
+		payload := map[string]any{
+			"description":         plan.Description.ValueString(),
+			"tags":                tagsPayload(ctx, plan.TagsAll, &resp.Diagnostics),
+			"deletion_protection": plan.DeletionProtection.ValueBool(),
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := c.PatchJSON(ctx, fmt.Sprintf("%s/%s", client.VMsEP, uuid), payload, nil); err != nil {
+			resp.Diagnostics.AddError("Failed to update VM", err.Error())
+			return
+		}
+	}
+
+	var vm models.VM
+	if err := c.GetJSON(ctx, fmt.Sprintf("%s/%s", client.VMsEP, uuid), nil, &vm); err != nil {
+		resp.Diagnostics.AddError("Failed to read VM after update", err.Error())
+		return
+	}
+
+	// Preserve fields not returned by API
+	savedPassword := state.OSPassword
+	savedAssignPublicIPs := plan.AssignPublicIPs
+
+	r.setModelFromVM(&plan, &vm)
+
+	plan.OSPassword = savedPassword
+	if !plan.StoreOSPassword.ValueBool() {
+		plan.OSPassword = types.StringNull()
+	}
+	plan.AssignPublicIPs = savedAssignPublicIPs
+	plan.OSPasswordWO = types.StringNull()
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *vmResource) Delete(ctx context.Context, req tfresource.DeleteRequest, resp *tfresource.DeleteResponse) {
+	ctx, span := tracing.StartOperation(ctx, "scamp_vm", "Delete")
+	defer tracing.End(span, &resp.Diagnostics)
+
 	var state vmModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	timeout, diags := state.Timeouts.Delete(ctx, deleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := regionalClient(ctx, r.c, state.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+
 	uuid := state.ID.ValueString()
+	tracing.SetUUID(ctx, uuid)
 	if uuid == "" {
 		return
 	}
 
-	if err := r.c.Delete(ctx, fmt.Sprintf("%s/%s", client.VMsEP, uuid)); err != nil {
+	if state.DeletionProtection.ValueBool() {
+		errDeletionProtected(resp, "VM")
+		return
+	}
+
+	if err := deleteAndWait(ctx, c, "scamp_vm", uuid, fmt.Sprintf("%s/%s", client.VMsEP, uuid), timeout); err != nil {
 		resp.Diagnostics.AddError("Failed to delete VM", err.Error())
 		return
 	}
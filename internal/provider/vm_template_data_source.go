@@ -3,13 +3,19 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 
+	goversion "github.com/hashicorp/go-version"
 	fwds "github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/serverscamp/terraform-provider-scamp/internal/client"
 	"github.com/serverscamp/terraform-provider-scamp/internal/models"
+	"github.com/serverscamp/terraform-provider-scamp/internal/tracing"
 )
 
 type vmTemplateDataSource struct {
@@ -24,11 +30,28 @@ func (d *vmTemplateDataSource) Metadata(_ context.Context, _ fwds.MetadataReques
 
 func (d *vmTemplateDataSource) Schema(_ context.Context, _ fwds.SchemaRequest, resp *fwds.SchemaResponse) {
 	resp.Schema = dsschema.Schema{
-		Description: "Find a VM template by os_type (e.g., 'Ubuntu', 'Alpine').",
+		Description: "Find a VM template by os_type (e.g., 'Ubuntu', 'Alpine'), os_family or api_name, optionally constrained by version.",
 		Attributes: map[string]dsschema.Attribute{
+			"region": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Region to read from. Defaults to the provider region.",
+			},
+			"include_inactive": dsschema.BoolAttribute{
+				Optional:    true,
+				Description: "Also include retired (inactive) templates.",
+			},
 			"os_type": dsschema.StringAttribute{
-				Required:    true,
-				Description: "OS type to search for (e.g., 'Ubuntu', 'Alpine').",
+				Optional:    true,
+				Computed:    true,
+				Description: "OS type to search for (e.g., 'Ubuntu', 'Alpine'). At least one of os_type, os_family or api_name must be set.",
+			},
+			"version_constraint": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Version constraint the template version must satisfy (e.g., '>= 22.04', '~> 12'). A bare major such as '~> 12' matches 12.x only.",
+			},
+			"most_recent": dsschema.BoolAttribute{
+				Optional:    true,
+				Description: "If several templates match, use the one with the highest version instead of failing. A lookup by os_type alone returns the first match unless this is set.",
 			},
 			"id": dsschema.Int64Attribute{
 				Computed:    true,
@@ -39,10 +62,12 @@ func (d *vmTemplateDataSource) Schema(_ context.Context, _ fwds.SchemaRequest, r
 				Description: "Name of the template.",
 			},
 			"api_name": dsschema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
 				Description: "API name of the template.",
 			},
 			"os_family": dsschema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
 				Description: "OS family (Linux, Windows, etc.).",
 			},
@@ -50,6 +75,10 @@ func (d *vmTemplateDataSource) Schema(_ context.Context, _ fwds.SchemaRequest, r
 				Computed:    true,
 				Description: "OS version.",
 			},
+			"is_active": dsschema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the template is active. Inactive entries are retired and cannot be used for new resources.",
+			},
 		},
 	}
 }
@@ -62,44 +91,146 @@ func (d *vmTemplateDataSource) Configure(_ context.Context, req fwds.ConfigureRe
 }
 
 type vmTemplateDataSourceModel struct {
-	OSType   types.String `tfsdk:"os_type"`
-	ID       types.Int64  `tfsdk:"id"`
-	Name     types.String `tfsdk:"name"`
-	APIName  types.String `tfsdk:"api_name"`
-	OSFamily types.String `tfsdk:"os_family"`
-	Version  types.String `tfsdk:"version"`
+	OSType            types.String `tfsdk:"os_type"`
+	VersionConstraint types.String `tfsdk:"version_constraint"`
+	MostRecent        types.Bool   `tfsdk:"most_recent"`
+	ID                types.Int64  `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	APIName           types.String `tfsdk:"api_name"`
+	OSFamily          types.String `tfsdk:"os_family"`
+	Version           types.String `tfsdk:"version"`
+	IncludeInactive   types.Bool   `tfsdk:"include_inactive"`
+	IsActive          types.Bool   `tfsdk:"is_active"`
+	Region            types.String `tfsdk:"region"`
+}
+
+// templateVersionNewer reports whether template a has a higher version than b.
+// Versions that cannot be parsed sort below all parseable ones.
+func templateVersionNewer(a, b models.VMTemplate) bool {
+	va, errA := goversion.NewVersion(a.Version)
+	vb, errB := goversion.NewVersion(b.Version)
+	switch {
+	case errA != nil && errB != nil:
+		return a.ID > b.ID
+	case errA != nil:
+		return false
+	case errB != nil:
+		return true
+	case va.Equal(vb):
+		return a.ID > b.ID
+	}
+	return va.GreaterThan(vb)
+}
+
+// pessimisticMajorRegexp matches a pessimistic constraint on a bare major
+// version, e.g. "~> 12".
+var pessimisticMajorRegexp = regexp.MustCompile(`^~>\s*v?([0-9]+)$`)
+
+// parseVersionConstraint parses a version constraint. go-version reads
+// "~> 12" as ">= 12" without an upper bound, so a bare major is narrowed to
+// ">= 12, < 13" to keep the lookup on that major.
+func parseVersionConstraint(s string) (goversion.Constraints, error) {
+	parts := strings.Split(s, ",")
+	for i, part := range parts {
+		m := pessimisticMajorRegexp.FindStringSubmatch(strings.TrimSpace(part))
+		if m == nil {
+			continue
+		}
+		major, err := strconv.Atoi(m[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid version %q: %w", m[1], err)
+		}
+		parts[i] = fmt.Sprintf(">= %d, < %d", major, major+1)
+	}
+	return goversion.NewConstraint(strings.Join(parts, ","))
 }
 
 func (d *vmTemplateDataSource) Read(ctx context.Context, req fwds.ReadRequest, resp *fwds.ReadResponse) {
+	ctx, span := tracing.StartDataSourceRead(ctx, "scamp_vm_template")
+	defer tracing.End(span, &resp.Diagnostics)
+
 	var config vmTemplateDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	osType := config.OSType.ValueString()
+	if !anySet(config.OSType, config.OSFamily, config.APIName) {
+		resp.Diagnostics.AddError("Invalid lookup", "At least one of os_type, os_family or api_name must be set.")
+		return
+	}
+
+	var constraints goversion.Constraints
+	if !config.VersionConstraint.IsNull() {
+		var err error
+		constraints, err = parseVersionConstraint(config.VersionConstraint.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("version_constraint"), "Invalid version constraint", err.Error())
+			return
+		}
+	}
+
+	c := regionalClient(ctx, d.c, config.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
 
 	var listResp models.VMTemplatesListResponse
-	err := d.c.GetJSON(ctx, client.VMTemplatesEP, nil, &listResp)
+	err := c.GetJSON(ctx, client.VMTemplatesEP, nil, &listResp)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read VM templates", err.Error())
 		return
 	}
 
+	var matches []models.VMTemplate
 	for _, item := range listResp.Items {
-		if !item.IsActive {
+		if !item.IsActive && !config.IncludeInactive.ValueBool() {
 			continue
 		}
-		if item.OSType == osType {
-			config.ID = types.Int64Value(int64(item.ID))
-			config.Name = types.StringValue(item.Name)
-			config.APIName = types.StringValue(item.APIName)
-			config.OSFamily = types.StringValue(item.OSFamily)
-			config.Version = types.StringValue(item.Version)
-			resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
-			return
+		if !matchString(config.OSType, item.OSType) ||
+			!matchString(config.OSFamily, item.OSFamily) ||
+			!matchString(config.APIName, item.APIName) {
+			continue
 		}
+		if constraints != nil {
+			v, err := goversion.NewVersion(item.Version)
+			if err != nil || !constraints.Check(v) {
+				continue
+			}
+		}
+		matches = append(matches, item)
+	}
+
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError("Template not found", "No active template matches the given criteria")
+		return
+	}
+
+	// A lookup by os_type alone keeps its original behaviour of returning
+	// the first match, so existing configurations don't break once the
+	// catalog carries several versions of an OS.
+	osTypeOnly := !anySet(config.OSFamily, config.APIName, config.VersionConstraint) && config.MostRecent.IsNull()
+
+	item := matches[0]
+	switch {
+	case config.MostRecent.ValueBool():
+		for _, m := range matches[1:] {
+			if templateVersionNewer(m, item) {
+				item = m
+			}
+		}
+	case len(matches) > 1 && !osTypeOnly:
+		resp.Diagnostics.AddError("Multiple templates found",
+			fmt.Sprintf("%d active templates match the given criteria. Narrow the lookup or set most_recent = true.", len(matches)))
+		return
 	}
 
-	resp.Diagnostics.AddError("Template not found", fmt.Sprintf("No active template with os_type '%s'", osType))
+	config.ID = types.Int64Value(int64(item.ID))
+	config.IsActive = types.BoolValue(item.IsActive)
+	config.Name = types.StringValue(item.Name)
+	config.OSType = types.StringValue(item.OSType)
+	config.APIName = types.StringValue(item.APIName)
+	config.OSFamily = types.StringValue(item.OSFamily)
+	config.Version = types.StringValue(item.Version)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
 }
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"testing"
+
+	goversion "github.com/hashicorp/go-version"
+
+	"github.com/serverscamp/terraform-provider-scamp/internal/models"
+)
+
+func TestParseVersionConstraintMostRecent(t *testing.T) {
+	templates := []models.VMTemplate{
+		{ID: 1, Version: "11.9"},
+		{ID: 2, Version: "12"},
+		{ID: 3, Version: "12.5"},
+		{ID: 4, Version: "13.1"},
+		{ID: 5, Version: "22.04"},
+		{ID: 6, Version: "24.04"},
+	}
+
+	tests := []struct {
+		constraint string
+		want       string
+	}{
+		{"~> 12", "12.5"},
+		{"~>12", "12.5"},
+		{"~> 12.0", "12.5"},
+		{">= 12, ~> 13", "13.1"},
+		{">= 22.04", "24.04"},
+	}
+	for _, tt := range tests {
+		constraints, err := parseVersionConstraint(tt.constraint)
+		if err != nil {
+			t.Fatalf("parseVersionConstraint(%q): %s", tt.constraint, err)
+		}
+
+		var best *models.VMTemplate
+		for i, item := range templates {
+			v, err := goversion.NewVersion(item.Version)
+			if err != nil || !constraints.Check(v) {
+				continue
+			}
+			if best == nil || templateVersionNewer(item, *best) {
+				best = &templates[i]
+			}
+		}
+		if best == nil {
+			t.Errorf("%q: no template matched, want %s", tt.constraint, tt.want)
+			continue
+		}
+		if best.Version != tt.want {
+			t.Errorf("%q: most recent match is %s, want %s", tt.constraint, best.Version, tt.want)
+		}
+	}
+}
+
+func TestParseVersionConstraintInvalid(t *testing.T) {
+	if _, err := parseVersionConstraint("~> twelve"); err == nil {
+		t.Error("expected an error for an invalid constraint")
+	}
+}
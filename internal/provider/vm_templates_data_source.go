@@ -9,6 +9,7 @@ import (
 
 	"github.com/serverscamp/terraform-provider-scamp/internal/client"
 	"github.com/serverscamp/terraform-provider-scamp/internal/models"
+	"github.com/serverscamp/terraform-provider-scamp/internal/tracing"
 )
 
 type vmTemplatesDataSource struct {
@@ -25,6 +26,14 @@ func (d *vmTemplatesDataSource) Schema(_ context.Context, _ fwds.SchemaRequest,
 	resp.Schema = dsschema.Schema{
 		Description: "Retrieves list of available VM templates (OS images).",
 		Attributes: map[string]dsschema.Attribute{
+			"region": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Region to read from. Defaults to the provider region.",
+			},
+			"include_inactive": dsschema.BoolAttribute{
+				Optional:    true,
+				Description: "Also include retired (inactive) templates.",
+			},
 			"items": dsschema.ListNestedAttribute{
 				Computed:    true,
 				Description: "List of VM templates.",
@@ -54,6 +63,10 @@ func (d *vmTemplatesDataSource) Schema(_ context.Context, _ fwds.SchemaRequest,
 							Computed:    true,
 							Description: "OS version.",
 						},
+						"is_active": dsschema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the template is active. Inactive entries are retired and cannot be used for new resources.",
+						},
 					},
 				},
 			},
@@ -75,23 +88,39 @@ type vmTemplateModel struct {
 	OSFamily types.String `tfsdk:"os_family"`
 	OSType   types.String `tfsdk:"os_type"`
 	Version  types.String `tfsdk:"version"`
+	IsActive types.Bool   `tfsdk:"is_active"`
 }
 
 type vmTemplatesDataSourceModel struct {
-	Items []vmTemplateModel `tfsdk:"items"`
+	IncludeInactive types.Bool        `tfsdk:"include_inactive"`
+	Items           []vmTemplateModel `tfsdk:"items"`
+	Region          types.String      `tfsdk:"region"`
 }
 
 func (d *vmTemplatesDataSource) Read(ctx context.Context, req fwds.ReadRequest, resp *fwds.ReadResponse) {
+	ctx, span := tracing.StartDataSourceRead(ctx, "scamp_vm_templates")
+	defer tracing.End(span, &resp.Diagnostics)
+
+	var state vmTemplatesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := regionalClient(ctx, d.c, state.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+
 	var listResp models.VMTemplatesListResponse
-	err := d.c.GetJSON(ctx, client.VMTemplatesEP, nil, &listResp)
+	err := c.GetJSON(ctx, client.VMTemplatesEP, nil, &listResp)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read VM templates", err.Error())
 		return
 	}
 
-	var state vmTemplatesDataSourceModel
 	for _, item := range listResp.Items {
-		if !item.IsActive {
+		if !item.IsActive && !state.IncludeInactive.ValueBool() {
 			continue
 		}
 		state.Items = append(state.Items, vmTemplateModel{
@@ -101,6 +130,7 @@ func (d *vmTemplatesDataSource) Read(ctx context.Context, req fwds.ReadRequest,
 			OSFamily: types.StringValue(item.OSFamily),
 			OSType:   types.StringValue(item.OSType),
 			Version:  types.StringValue(item.Version),
+			IsActive: types.BoolValue(item.IsActive),
 		})
 	}
 
@@ -0,0 +1,299 @@
+package provider
+
+import (
+	"context"
+
+	fwds "github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/serverscamp/terraform-provider-scamp/internal/client"
+	"github.com/serverscamp/terraform-provider-scamp/internal/models"
+	"github.com/serverscamp/terraform-provider-scamp/internal/tracing"
+)
+
+type vmsDataSource struct {
+	c *client.Client
+}
+
+func NewVMsDataSource() fwds.DataSource { return &vmsDataSource{} }
+
+func (d *vmsDataSource) Metadata(_ context.Context, _ fwds.MetadataRequest, resp *fwds.MetadataResponse) {
+	resp.TypeName = "scamp_vms"
+}
+
+func (d *vmsDataSource) Schema(_ context.Context, _ fwds.SchemaRequest, resp *fwds.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Description: "Retrieves list of VMs, optionally filtered.",
+		Attributes: map[string]dsschema.Attribute{
+			"region": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Region to read from. Defaults to the provider region.",
+			},
+			"items": dsschema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of VMs.",
+				NestedObject: dsschema.NestedAttributeObject{
+					Attributes: map[string]dsschema.Attribute{
+						"id": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "UUID of the VM.",
+						},
+						"display_name": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Display name of the VM.",
+						},
+						"vm_name": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "System name of the VM.",
+						},
+						"vm_class_id": dsschema.Int64Attribute{
+							Computed:    true,
+							Description: "ID of the VM class.",
+						},
+						"root_disk_class_id": dsschema.Int64Attribute{
+							Computed:    true,
+							Description: "ID of the storage class for root disk.",
+						},
+						"primary_network_class_id": dsschema.Int64Attribute{
+							Computed:    true,
+							Description: "ID of the network class for primary network.",
+						},
+						"vm_template_id": dsschema.Int64Attribute{
+							Computed:    true,
+							Description: "ID of the VM template.",
+						},
+						"primary_network_id": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "ID (UUID) of the primary network.",
+						},
+						"ssh_key_id": dsschema.Int64Attribute{
+							Computed:    true,
+							Description: "ID of the SSH key.",
+						},
+						"root_disk_gb": dsschema.Int64Attribute{
+							Computed:    true,
+							Description: "Root disk size in GB.",
+						},
+						"cpu_cores": dsschema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of vCPU cores.",
+						},
+						"memory_mb": dsschema.Int64Attribute{
+							Computed:    true,
+							Description: "Memory in MB.",
+						},
+						"os_user": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "OS username.",
+						},
+						"status": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Status of the VM.",
+						},
+						"state": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "State of the VM (running, stopped, etc.).",
+						},
+						"ip_internal": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Internal IP address.",
+						},
+						"ipv6_address": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "IPv6 address.",
+						},
+						"public_ip_v4": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Public IPv4 address.",
+						},
+						"public_ip_v6": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Public IPv6 address.",
+						},
+						"tags": dsschema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Tags of the VM.",
+						},
+						"created_at": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Timestamp when the VM was created.",
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]dsschema.Block{
+			"filter": dsschema.SingleNestedBlock{
+				Description: "Only return VMs matching all of the given criteria.",
+				Attributes: map[string]dsschema.Attribute{
+					"status": dsschema.StringAttribute{
+						Optional:    true,
+						Description: "Status of the VM.",
+					},
+					"state": dsschema.StringAttribute{
+						Optional:    true,
+						Description: "State of the VM (running, stopped, etc.).",
+					},
+					"name_regex": dsschema.StringAttribute{
+						Optional:    true,
+						Description: "Regular expression matched against display_name and vm_name.",
+					},
+					"network_id": dsschema.StringAttribute{
+						Optional:    true,
+						Description: "UUID of the primary network.",
+					},
+					"tags": dsschema.MapAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "Only return VMs having all of the given tags.",
+					},
+					"tags_any": dsschema.MapAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "Only return VMs having at least one of the given tags.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *vmsDataSource) Configure(_ context.Context, req fwds.ConfigureRequest, _ *fwds.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.c = req.ProviderData.(*client.Client)
+}
+
+type vmItemModel struct {
+	ID                    types.String `tfsdk:"id"`
+	DisplayName           types.String `tfsdk:"display_name"`
+	VMName                types.String `tfsdk:"vm_name"`
+	VMClassID             types.Int64  `tfsdk:"vm_class_id"`
+	RootDiskClassID       types.Int64  `tfsdk:"root_disk_class_id"`
+	PrimaryNetworkClassID types.Int64  `tfsdk:"primary_network_class_id"`
+	VMTemplateID          types.Int64  `tfsdk:"vm_template_id"`
+	PrimaryNetworkID      types.String `tfsdk:"primary_network_id"`
+	SSHKeyID              types.Int64  `tfsdk:"ssh_key_id"`
+	RootDiskGB            types.Int64  `tfsdk:"root_disk_gb"`
+	CPUCores              types.Int64  `tfsdk:"cpu_cores"`
+	MemoryMB              types.Int64  `tfsdk:"memory_mb"`
+	OSUser                types.String `tfsdk:"os_user"`
+	Status                types.String `tfsdk:"status"`
+	State                 types.String `tfsdk:"state"`
+	IPInternal            types.String `tfsdk:"ip_internal"`
+	IPv6Address           types.String `tfsdk:"ipv6_address"`
+	PublicIPv4            types.String `tfsdk:"public_ip_v4"`
+	PublicIPv6            types.String `tfsdk:"public_ip_v6"`
+	Tags                  types.Map    `tfsdk:"tags"`
+	CreatedAt             types.String `tfsdk:"created_at"`
+}
+
+type vmsFilterModel struct {
+	Status    types.String `tfsdk:"status"`
+	State     types.String `tfsdk:"state"`
+	NameRegex types.String `tfsdk:"name_regex"`
+	NetworkID types.String `tfsdk:"network_id"`
+	Tags      types.Map    `tfsdk:"tags"`
+	TagsAny   types.Map    `tfsdk:"tags_any"`
+}
+
+type vmsDataSourceModel struct {
+	Filter *vmsFilterModel `tfsdk:"filter"`
+	Items  []vmItemModel   `tfsdk:"items"`
+	Region types.String    `tfsdk:"region"`
+}
+
+func vmItemFromAPI(vm models.VM) vmItemModel {
+	item := vmItemModel{
+		ID:                    types.StringValue(vm.VMUUID),
+		DisplayName:           types.StringValue(vm.DisplayName),
+		VMName:                types.StringValue(vm.VMName),
+		VMClassID:             types.Int64Value(int64(vm.VMClassID)),
+		RootDiskClassID:       types.Int64Value(int64(vm.StorageClassID)),
+		PrimaryNetworkClassID: types.Int64Value(int64(vm.NetworkClassID)),
+		VMTemplateID:          types.Int64Value(int64(vm.VMTemplateID)),
+		PrimaryNetworkID:      types.StringValue(vm.NetworkUUID),
+		SSHKeyID:              types.Int64Null(),
+		RootDiskGB:            types.Int64Value(int64(vm.DiskGB)),
+		CPUCores:              types.Int64Value(int64(vm.CPUCores)),
+		MemoryMB:              types.Int64Value(int64(vm.MemoryMB)),
+		OSUser:                types.StringValue(vm.OSUser),
+		Status:                types.StringValue(vm.Status),
+		State:                 types.StringValue(vm.State),
+		IPInternal:            types.StringNull(),
+		IPv6Address:           types.StringNull(),
+		PublicIPv4:            types.StringNull(),
+		PublicIPv6:            types.StringNull(),
+		Tags:                  tagsValue(vm.Tags),
+		CreatedAt:             types.StringValue(vm.CreatedAt),
+	}
+	if vm.SSHKeyID != nil {
+		item.SSHKeyID = types.Int64Value(int64(*vm.SSHKeyID))
+	}
+	if vm.Network != nil {
+		item.IPInternal = types.StringValue(vm.Network.IPInternal)
+		item.IPv6Address = types.StringValue(vm.Network.IPv6Address)
+		item.PublicIPv4 = types.StringValue(vm.Network.PublicIPv4)
+		item.PublicIPv6 = types.StringValue(vm.Network.PublicIPv6)
+	}
+	return item
+}
+
+func (d *vmsDataSource) Read(ctx context.Context, req fwds.ReadRequest, resp *fwds.ReadResponse) {
+	ctx, span := tracing.StartDataSourceRead(ctx, "scamp_vms")
+	defer tracing.End(span, &resp.Diagnostics)
+
+	var state vmsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := state.Filter
+	if filter == nil {
+		filter = &vmsFilterModel{}
+	}
+	nameRe := compileNameRegex(filter.NameRegex, path.Root("filter").AtName("name_regex"), &resp.Diagnostics)
+	tags := tagsPayload(ctx, filter.Tags, &resp.Diagnostics)
+	tagsAny := tagsPayload(ctx, filter.TagsAny, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := regionalClient(ctx, d.c, state.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+
+	q := tagQuery(nil, "tag", tags)
+	q = tagQuery(q, "tag_any", tagsAny)
+	vms, err := listAll(ctx, c, client.VMsEP, q, func(r *models.VMsListResponse) ([]models.VM, int) {
+		return r.Items, r.Total
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list VMs", err.Error())
+		return
+	}
+
+	state.Items = []vmItemModel{}
+	for _, vm := range vms {
+		if !hasAllTags(vm.Tags, tags) || !hasAnyTag(vm.Tags, tagsAny) {
+			continue
+		}
+		if !matchString(filter.Status, vm.Status) ||
+			!matchString(filter.State, vm.State) ||
+			!matchString(filter.NetworkID, vm.NetworkUUID) {
+			continue
+		}
+		if nameRe != nil && !nameRe.MatchString(vm.DisplayName) && !nameRe.MatchString(vm.VMName) {
+			continue
+		}
+		state.Items = append(state.Items, vmItemFromAPI(vm))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
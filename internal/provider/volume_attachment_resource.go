@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/serverscamp/terraform-provider-scamp/internal/client"
+	"github.com/serverscamp/terraform-provider-scamp/internal/models"
+	"github.com/serverscamp/terraform-provider-scamp/internal/tracing"
+)
+
+type volumeAttachmentResource struct {
+	c *client.Client
+}
+
+func NewVolumeAttachmentResource() tfresource.Resource { return &volumeAttachmentResource{} }
+
+func (r *volumeAttachmentResource) Metadata(_ context.Context, _ tfresource.MetadataRequest, resp *tfresource.MetadataResponse) {
+	resp.TypeName = "scamp_volume_attachment"
+}
+
+func (r *volumeAttachmentResource) Schema(_ context.Context, _ tfresource.SchemaRequest, resp *tfresource.SchemaResponse) {
+	resp.Schema = rschema.Schema{
+		Description: "Attaches a volume to a VM in SCAMP. Do not combine with attached_vm_id on the same scamp_volume.",
+		Attributes: map[string]rschema.Attribute{
+			"region": regionAttribute(),
+			"id": rschema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the attachment in the form volume_id/vm_id.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"volume_id": rschema.StringAttribute{
+				Required:    true,
+				Description: "UUID of the volume to attach.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vm_id": rschema.StringAttribute{
+				Required:    true,
+				Description: "UUID of the VM to attach the volume to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *volumeAttachmentResource) Configure(_ context.Context, req tfresource.ConfigureRequest, _ *tfresource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.c = req.ProviderData.(*client.Client)
+}
+
+type volumeAttachmentModel struct {
+	ID       types.String `tfsdk:"id"`
+	VolumeID types.String `tfsdk:"volume_id"`
+	VMID     types.String `tfsdk:"vm_id"`
+	Region   types.String `tfsdk:"region"`
+}
+
+func (r *volumeAttachmentResource) Create(ctx context.Context, req tfresource.CreateRequest, resp *tfresource.CreateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "scamp_volume_attachment", "Create")
+	defer tracing.End(span, &resp.Diagnostics)
+
+	var plan volumeAttachmentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := activeRegionalClient(ctx, r.c, plan.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+	plan.Region = types.StringValue(c.Region)
+
+	volumeID := plan.VolumeID.ValueString()
+	vmID := plan.VMID.ValueString()
+	tracing.SetUUID(ctx, volumeID)
+
+	attachPayload := map[string]any{
+		"vm_uuid": vmID,
+	}
+	var attachResp models.VolumeAttachResponse
+	if err := c.PostJSON(ctx, fmt.Sprintf("%s/%s/attach", client.VolumesEP, volumeID), attachPayload, &attachResp); err != nil {
+		resp.Diagnostics.AddError("Failed to attach volume to VM", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(volumeID + "/" + vmID)
+
+	// Keep the attachment in state even if it never reports attached, so it
+	// is tainted rather than lost
+	if _, err := waitForVolumeState(ctx, c, volumeID, []string{"attached"}, 5*time.Minute); err != nil {
+		resp.Diagnostics.AddError("Failed to attach volume to VM", err.Error())
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *volumeAttachmentResource) Read(ctx context.Context, req tfresource.ReadRequest, resp *tfresource.ReadResponse) {
+	ctx, span := tracing.StartOperation(ctx, "scamp_volume_attachment", "Read")
+	defer tracing.End(span, &resp.Diagnostics)
+
+	var state volumeAttachmentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := regionalClient(ctx, r.c, state.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+	state.Region = types.StringValue(c.Region)
+
+	volumeID := state.VolumeID.ValueString()
+	tracing.SetUUID(ctx, volumeID)
+
+	var vol models.Volume
+	err := c.GetJSON(ctx, fmt.Sprintf("%s/%s", client.VolumesEP, volumeID), nil, &vol)
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read volume attachment", err.Error())
+		return
+	}
+
+	// Detached or moved to another VM outside Terraform
+	if vol.VMUUID == nil || *vol.VMUUID != state.VMID.ValueString() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(volumeID + "/" + *vol.VMUUID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *volumeAttachmentResource) Update(ctx context.Context, req tfresource.UpdateRequest, resp *tfresource.UpdateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "scamp_volume_attachment", "Update")
+	defer tracing.End(span, &resp.Diagnostics)
+
+	// Every attribute requires replace - just preserve plan values
+	var plan volumeAttachmentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *volumeAttachmentResource) Delete(ctx context.Context, req tfresource.DeleteRequest, resp *tfresource.DeleteResponse) {
+	ctx, span := tracing.StartOperation(ctx, "scamp_volume_attachment", "Delete")
+	defer tracing.End(span, &resp.Diagnostics)
+
+	var state volumeAttachmentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := regionalClient(ctx, r.c, state.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+
+	volumeID := state.VolumeID.ValueString()
+	tracing.SetUUID(ctx, volumeID)
+
+	if err := c.PostJSON(ctx, fmt.Sprintf("%s/%s/detach", client.VolumesEP, volumeID), nil, nil); err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Failed to detach volume from VM", err.Error())
+		return
+	}
+
+	if _, err := waitForVolumeState(ctx, c, volumeID, []string{"provisioned", "detached"}, 5*time.Minute); err != nil {
+		resp.Diagnostics.AddError("Failed to detach volume from VM", err.Error())
+		return
+	}
+}
+
+func (r *volumeAttachmentResource) ImportState(ctx context.Context, req tfresource.ImportStateRequest, resp *tfresource.ImportStateResponse) {
+	// volume_id/vm_id, optionally prefixed with the region of the volume
+	var region, volumeID, vmID string
+	switch parts := strings.Split(req.ID, "/"); len(parts) {
+	case 2:
+		volumeID, vmID = parts[0], parts[1]
+	case 3:
+		region, volumeID, vmID = parts[0], parts[1], parts[2]
+	}
+	if volumeID == "" || vmID == "" || strings.HasPrefix(req.ID, "/") {
+		resp.Diagnostics.AddError("Invalid import ID",
+			fmt.Sprintf("Expected an ID of the form volume_id/vm_id or region/volume_id/vm_id, got %q.", req.ID))
+		return
+	}
+
+	if region != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), region)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), volumeID+"/"+vmID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("volume_id"), volumeID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vm_id"), vmID)...)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/serverscamp/terraform-provider-scamp/internal/client"
 	"github.com/serverscamp/terraform-provider-scamp/internal/models"
+	"github.com/serverscamp/terraform-provider-scamp/internal/tracing"
 )
 
 type volumeDataSource struct {
@@ -24,16 +25,26 @@ func (d *volumeDataSource) Metadata(_ context.Context, _ fwds.MetadataRequest, r
 
 func (d *volumeDataSource) Schema(_ context.Context, _ fwds.SchemaRequest, resp *fwds.SchemaResponse) {
 	resp.Schema = dsschema.Schema{
-		Description: "Retrieves information about an existing volume by UUID.",
+		Description: "Retrieves information about an existing volume by UUID, display name or attached VM.",
 		Attributes: map[string]dsschema.Attribute{
+			"region": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Region to read from. Defaults to the provider region.",
+			},
 			"id": dsschema.StringAttribute{
-				Required:    true,
-				Description: "The UUID of the volume to retrieve.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The UUID of the volume to retrieve. Exactly one of id, display_name or attached_vm_id must be set.",
 			},
 			"display_name": dsschema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
 				Description: "Display name of the volume.",
 			},
+			"most_recent": dsschema.BoolAttribute{
+				Optional:    true,
+				Description: "If several volumes match, use the most recently created one instead of failing.",
+			},
 			"size_gb": dsschema.Int64Attribute{
 				Computed:    true,
 				Description: "Size of the volume in GB.",
@@ -43,6 +54,7 @@ func (d *volumeDataSource) Schema(_ context.Context, _ fwds.SchemaRequest, resp
 				Description: "ID of the storage class.",
 			},
 			"attached_vm_id": dsschema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
 				Description: "UUID of the VM the volume is attached to (null if not attached).",
 			},
@@ -70,11 +82,19 @@ func (d *volumeDataSource) Schema(_ context.Context, _ fwds.SchemaRequest, resp
 				Computed:    true,
 				Description: "Write bandwidth limit (MB/s).",
 			},
+			"tags": dsschema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Tags of the volume.",
+			},
 			"created_at": dsschema.StringAttribute{
 				Computed:    true,
 				Description: "Timestamp when the volume was created.",
 			},
 		},
+		Blocks: map[string]dsschema.Block{
+			"filter": tagFilterBlock("volumes"),
+		},
 	}
 }
 
@@ -86,34 +106,98 @@ func (d *volumeDataSource) Configure(_ context.Context, req fwds.ConfigureReques
 }
 
 type volumeDataSourceModel struct {
-	ID                  types.String `tfsdk:"id"`
-	DisplayName         types.String `tfsdk:"display_name"`
-	SizeGB              types.Int64  `tfsdk:"size_gb"`
-	StorageClassID      types.Int64  `tfsdk:"storage_class_id"`
-	AttachedVMID        types.String `tfsdk:"attached_vm_id"`
-	State               types.String `tfsdk:"state"`
-	SDSPoolName         types.String `tfsdk:"sds_pool_name"`
-	ReadIOPSLimit       types.Int64  `tfsdk:"read_iops_limit"`
-	WriteIOPSLimit      types.Int64  `tfsdk:"write_iops_limit"`
-	ReadBandwidthLimit  types.Int64  `tfsdk:"read_bandwidth_limit"`
-	WriteBandwidthLimit types.Int64  `tfsdk:"write_bandwidth_limit"`
-	CreatedAt           types.String `tfsdk:"created_at"`
+	ID                  types.String    `tfsdk:"id"`
+	DisplayName         types.String    `tfsdk:"display_name"`
+	MostRecent          types.Bool      `tfsdk:"most_recent"`
+	SizeGB              types.Int64     `tfsdk:"size_gb"`
+	StorageClassID      types.Int64     `tfsdk:"storage_class_id"`
+	AttachedVMID        types.String    `tfsdk:"attached_vm_id"`
+	State               types.String    `tfsdk:"state"`
+	SDSPoolName         types.String    `tfsdk:"sds_pool_name"`
+	ReadIOPSLimit       types.Int64     `tfsdk:"read_iops_limit"`
+	WriteIOPSLimit      types.Int64     `tfsdk:"write_iops_limit"`
+	ReadBandwidthLimit  types.Int64     `tfsdk:"read_bandwidth_limit"`
+	WriteBandwidthLimit types.Int64     `tfsdk:"write_bandwidth_limit"`
+	Tags                types.Map       `tfsdk:"tags"`
+	CreatedAt           types.String    `tfsdk:"created_at"`
+	Filter              *tagFilterModel `tfsdk:"filter"`
+	Region              types.String    `tfsdk:"region"`
 }
 
 func (d *volumeDataSource) Read(ctx context.Context, req fwds.ReadRequest, resp *fwds.ReadResponse) {
+	ctx, span := tracing.StartDataSourceRead(ctx, "scamp_volume")
+	defer tracing.End(span, &resp.Diagnostics)
+
 	var config volumeDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	uuid := config.ID.ValueString()
+	// Tag filters narrow any lookup and can also be used on their own
+	tags, tagsAny := config.Filter.tags(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(tags) == 0 && len(tagsAny) == 0 || anySet(config.ID, config.DisplayName, config.AttachedVMID) {
+		if !checkLookupArgs(&resp.Diagnostics,
+			lookupArg{"id", !config.ID.IsNull()},
+			lookupArg{"display_name", !config.DisplayName.IsNull()},
+			lookupArg{"attached_vm_id", !config.AttachedVMID.IsNull()},
+		) {
+			return
+		}
+	}
+
+	c := regionalClient(ctx, d.c, config.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
 
 	var vol models.Volume
-	err := d.c.GetJSON(ctx, fmt.Sprintf("%s/%s", client.VolumesEP, uuid), nil, &vol)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to read volume", err.Error())
-		return
+	if !config.ID.IsNull() {
+		uuid := config.ID.ValueString()
+		tracing.SetUUID(ctx, uuid)
+
+		err := c.GetJSON(ctx, fmt.Sprintf("%s/%s", client.VolumesEP, uuid), nil, &vol)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read volume", err.Error())
+			return
+		}
+		if !hasAllTags(vol.Tags, tags) || !hasAnyTag(vol.Tags, tagsAny) {
+			resp.Diagnostics.AddError("No volume found", fmt.Sprintf("Volume %s does not match the given tags.", uuid))
+			return
+		}
+	} else {
+		volumes, err := listAll(ctx, c, client.VolumesEP, tagQuery(tagQuery(nil, "tag", tags), "tag_any", tagsAny), func(r *models.VolumesListResponse) ([]models.Volume, int) {
+			return r.Items, r.Total
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to list volumes", err.Error())
+			return
+		}
+
+		var matches []models.Volume
+		for _, item := range volumes {
+			if !hasAllTags(item.Tags, tags) || !hasAnyTag(item.Tags, tagsAny) {
+				continue
+			}
+			attachedVMID := ""
+			if item.VMUUID != nil {
+				attachedVMID = *item.VMUUID
+			}
+			if !matchString(config.DisplayName, item.DisplayName) || !matchString(config.AttachedVMID, attachedVMID) {
+				continue
+			}
+			matches = append(matches, item)
+		}
+
+		var ok bool
+		vol, ok = selectOne(matches, func(v models.Volume) string { return v.CreatedAt }, config.MostRecent.ValueBool(), "volume", &resp.Diagnostics)
+		if !ok {
+			return
+		}
+		tracing.SetUUID(ctx, vol.DiskUUID)
 	}
 
 	config.ID = types.StringValue(vol.DiskUUID)
@@ -123,6 +207,7 @@ func (d *volumeDataSource) Read(ctx context.Context, req fwds.ReadRequest, resp
 	config.State = types.StringValue(vol.State)
 	config.SDSPoolName = types.StringValue(vol.SDSPoolName)
 	config.CreatedAt = types.StringValue(vol.CreatedAt)
+	config.Tags = tagsValue(vol.Tags)
 
 	if vol.VMUUID != nil {
 		config.AttachedVMID = types.StringValue(*vol.VMUUID)
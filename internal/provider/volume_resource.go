@@ -5,16 +5,21 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/serverscamp/terraform-provider-scamp/internal/client"
 	"github.com/serverscamp/terraform-provider-scamp/internal/models"
+	"github.com/serverscamp/terraform-provider-scamp/internal/tracing"
 )
 
 type volumeResource struct {
@@ -27,10 +32,11 @@ func (r *volumeResource) Metadata(_ context.Context, _ tfresource.MetadataReques
 	resp.TypeName = "scamp_volume"
 }
 
-func (r *volumeResource) Schema(_ context.Context, _ tfresource.SchemaRequest, resp *tfresource.SchemaResponse) {
+func (r *volumeResource) Schema(ctx context.Context, _ tfresource.SchemaRequest, resp *tfresource.SchemaResponse) {
 	resp.Schema = rschema.Schema{
 		Description: "Manages a volume (disk) in SCAMP.",
 		Attributes: map[string]rschema.Attribute{
+			"region": regionAttribute(),
 			"id": rschema.StringAttribute{
 				Computed:    true,
 				Description: "The UUID of the volume.",
@@ -42,6 +48,9 @@ func (r *volumeResource) Schema(_ context.Context, _ tfresource.SchemaRequest, r
 				Optional:    true,
 				Computed:    true,
 				Description: "Display name of the volume (max 100 characters).",
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(100),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -49,6 +58,9 @@ func (r *volumeResource) Schema(_ context.Context, _ tfresource.SchemaRequest, r
 			"size_gb": rschema.Int64Attribute{
 				Required:    true,
 				Description: "Size of the volume in GB (1-10000).",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 10000),
+				},
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.RequiresReplace(),
 				},
@@ -62,8 +74,16 @@ func (r *volumeResource) Schema(_ context.Context, _ tfresource.SchemaRequest, r
 			},
 			"attached_vm_id": rschema.StringAttribute{
 				Optional:    true,
-				Description: "UUID of the VM to attach the volume to. If not set, volume is created but not attached.",
+				Description: "UUID of the VM to attach the volume to. If not set, volume is created but not attached and attachments made elsewhere (e.g. scamp_volume_attachment) are ignored. Do not set when using scamp_volume_attachment.",
+			},
+			"tags": rschema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Tags to assign to the volume as key-value pairs.",
 			},
+			"tags_all":            tagsAllAttribute("volume"),
+			"delete_on_failure":   deleteOnFailureAttribute("volume"),
+			"deletion_protection": deletionProtectionAttribute("volume"),
 			// Computed fields
 			"state": rschema.StringAttribute{
 				Computed:    true,
@@ -100,6 +120,9 @@ func (r *volumeResource) Schema(_ context.Context, _ tfresource.SchemaRequest, r
 				},
 			},
 		},
+		Blocks: map[string]rschema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Delete: true}),
+		},
 	}
 }
 
@@ -111,18 +134,24 @@ func (r *volumeResource) Configure(_ context.Context, req tfresource.ConfigureRe
 }
 
 type volumeModel struct {
-	ID                  types.String `tfsdk:"id"`
-	DisplayName         types.String `tfsdk:"display_name"`
-	SizeGB              types.Int64  `tfsdk:"size_gb"`
-	StorageClassID      types.Int64  `tfsdk:"storage_class_id"`
-	AttachedVMID        types.String `tfsdk:"attached_vm_id"`
-	State               types.String `tfsdk:"state"`
-	SDSPoolName         types.String `tfsdk:"sds_pool_name"`
-	ReadIOPSLimit       types.Int64  `tfsdk:"read_iops_limit"`
-	WriteIOPSLimit      types.Int64  `tfsdk:"write_iops_limit"`
-	ReadBandwidthLimit  types.Int64  `tfsdk:"read_bandwidth_limit"`
-	WriteBandwidthLimit types.Int64  `tfsdk:"write_bandwidth_limit"`
-	CreatedAt           types.String `tfsdk:"created_at"`
+	ID                  types.String   `tfsdk:"id"`
+	DisplayName         types.String   `tfsdk:"display_name"`
+	SizeGB              types.Int64    `tfsdk:"size_gb"`
+	StorageClassID      types.Int64    `tfsdk:"storage_class_id"`
+	AttachedVMID        types.String   `tfsdk:"attached_vm_id"`
+	Tags                types.Map      `tfsdk:"tags"`
+	TagsAll             types.Map      `tfsdk:"tags_all"`
+	DeleteOnFailure     types.Bool     `tfsdk:"delete_on_failure"`
+	DeletionProtection  types.Bool     `tfsdk:"deletion_protection"`
+	State               types.String   `tfsdk:"state"`
+	SDSPoolName         types.String   `tfsdk:"sds_pool_name"`
+	ReadIOPSLimit       types.Int64    `tfsdk:"read_iops_limit"`
+	WriteIOPSLimit      types.Int64    `tfsdk:"write_iops_limit"`
+	ReadBandwidthLimit  types.Int64    `tfsdk:"read_bandwidth_limit"`
+	WriteBandwidthLimit types.Int64    `tfsdk:"write_bandwidth_limit"`
+	CreatedAt           types.String   `tfsdk:"created_at"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+	Region              types.String   `tfsdk:"region"`
 }
 
 func (r *volumeResource) setModelFromVolume(m *volumeModel, vol *models.Volume) {
@@ -132,11 +161,17 @@ func (r *volumeResource) setModelFromVolume(m *volumeModel, vol *models.Volume)
 	m.StorageClassID = types.Int64Value(int64(vol.StorageClassID))
 	m.State = types.StringValue(vol.State)
 	m.SDSPoolName = types.StringValue(vol.SDSPoolName)
-
-	if vol.VMUUID != nil {
-		m.AttachedVMID = types.StringValue(*vol.VMUUID)
-	} else {
-		m.AttachedVMID = types.StringNull()
+	m.Tags = tagsFromAPI(m.Tags, vol.Tags, r.c.DefaultTags)
+	m.TagsAll = tagsValue(vol.Tags)
+
+	// attached_vm_id is only tracked when set in config, so attachments
+	// managed by scamp_volume_attachment don't show up as drift
+	if !m.AttachedVMID.IsNull() {
+		if vol.VMUUID != nil {
+			m.AttachedVMID = types.StringValue(*vol.VMUUID)
+		} else {
+			m.AttachedVMID = types.StringNull()
+		}
 	}
 
 	if vol.Limits != nil {
@@ -151,11 +186,13 @@ func (r *volumeResource) setModelFromVolume(m *volumeModel, vol *models.Volume)
 	}
 }
 
-func (r *volumeResource) waitForVolumeState(ctx context.Context, uuid string, targetStates []string, timeout time.Duration) (*models.Volume, error) {
+func waitForVolumeState(ctx context.Context, c *client.Client, uuid string, targetStates []string, timeout time.Duration) (*models.Volume, error) {
 	deadline := time.Now().Add(timeout)
-	for {
+	for attempt := 1; ; attempt++ {
 		var vol models.Volume
-		err := r.c.GetJSON(ctx, fmt.Sprintf("%s/%s", client.VolumesEP, uuid), nil, &vol)
+		pollCtx, span := startPollSpan(ctx, "scamp_volume", uuid, attempt)
+		err := c.GetJSON(pollCtx, fmt.Sprintf("%s/%s", client.VolumesEP, uuid), nil, &vol)
+		endPollSpan(span, vol.State, err)
 		if err != nil {
 			return nil, err
 		}
@@ -164,8 +201,8 @@ func (r *volumeResource) waitForVolumeState(ctx context.Context, uuid string, ta
 				return &vol, nil
 			}
 		}
-		if vol.State == "error" {
-			return &vol, fmt.Errorf("volume %s entered error state", uuid)
+		if isFailedState(vol.State) {
+			return &vol, fmt.Errorf("volume %s entered %s state", uuid, vol.State)
 		}
 		if time.Now().After(deadline) {
 			return &vol, fmt.Errorf("timeout waiting for volume %s to reach state %v (current: %s)", uuid, targetStates, vol.State)
@@ -179,13 +216,76 @@ func (r *volumeResource) waitForVolumeState(ctx context.Context, uuid string, ta
 	}
 }
 
+func (r *volumeResource) ModifyPlan(ctx context.Context, req tfresource.ModifyPlanRequest, resp *tfresource.ModifyPlanResponse) {
+	checkDeletionProtection(ctx, req, resp, "volume", "size_gb", "storage_class_id", "region")
+
+	// Nothing to check on destroy or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.c == nil {
+		return
+	}
+
+	planTagsAll(ctx, r.c, req, resp)
+
+	var plan volumeModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// size_gb and storage_class_id force replacement, so they only need to be
+	// valid for volumes that will be (re)created.
+	var state *volumeModel
+	if !req.State.Raw.IsNull() {
+		state = &volumeModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	replacing := state == nil ||
+		!plan.StorageClassID.Equal(state.StorageClassID) ||
+		!plan.SizeGB.Equal(state.SizeGB) ||
+		!plan.Region.Equal(state.Region)
+
+	c := regionalClient(ctx, r.c, plan.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+
+	cat, err := fetchCatalog(ctx, c)
+	if err != nil {
+		tflog.Warn(ctx, "Skipping catalog checks", map[string]any{"error": err.Error()})
+		return
+	}
+
+	if id, ok := knownID(plan.StorageClassID); ok {
+		if storageClass, found := cat.storageClasses[id]; found {
+			warnInactive(&resp.Diagnostics, "storage_class_id", "storage class", storageClass.Name, id, storageClass.IsActive)
+			if replacing {
+				checkDiskSize(&resp.Diagnostics, "size_gb", plan.SizeGB, storageClass)
+			}
+		} else if replacing {
+			errNotInCatalog(&resp.Diagnostics, "storage_class_id", "storage class", id)
+		}
+	}
+}
+
 func (r *volumeResource) Create(ctx context.Context, req tfresource.CreateRequest, resp *tfresource.CreateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "scamp_volume", "Create")
+	defer tracing.End(span, &resp.Diagnostics)
+
 	var plan volumeModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	c := activeRegionalClient(ctx, r.c, plan.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+	plan.Region = types.StringValue(c.Region)
+
 	payload := map[string]any{
 		"size_gb":          plan.SizeGB.ValueInt64(),
 		"storage_class_id": plan.StorageClassID.ValueInt64(),
@@ -194,24 +294,41 @@ func (r *volumeResource) Create(ctx context.Context, req tfresource.CreateReques
 	if !plan.DisplayName.IsNull() && plan.DisplayName.ValueString() != "" {
 		payload["display_name"] = plan.DisplayName.ValueString()
 	}
+	if tags := tagsPayload(ctx, plan.TagsAll, &resp.Diagnostics); len(tags) > 0 {
+		payload["tags"] = tags
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.DeletionProtection.ValueBool() {
+		// Server-side lock; also blocks deletion from the web console and API
+		payload["deletion_protection"] = true
+	}
 
 	var createResp models.VolumeCreateResponse
-	if err := r.c.PostJSON(ctx, client.VolumesEP, payload, &createResp); err != nil {
+	if err := c.PostJSON(ctx, client.VolumesEP, payload, &createResp); err != nil {
 		resp.Diagnostics.AddError("Failed to create volume", err.Error())
 		return
 	}
 
 	plan.ID = types.StringValue(createResp.DiskUUID)
+	tracing.SetUUID(ctx, createResp.DiskUUID)
 
 	// Save attached_vm_id from plan (not returned by API until attached)
 	wantAttachVMID := plan.AttachedVMID
 
 	// Wait for volume to become provisioned
-	vol, err := r.waitForVolumeState(ctx, createResp.DiskUUID, []string{"provisioned"}, 5*time.Minute)
-	if err != nil {
-		resp.Diagnostics.AddWarning("Volume created but not yet available", err.Error())
-	} else {
+	vol, err := waitForVolumeState(ctx, c, createResp.DiskUUID, []string{"provisioned"}, 5*time.Minute)
+	if vol != nil {
 		r.setModelFromVolume(&plan, vol)
+		plan.AttachedVMID = wantAttachVMID
+	}
+	if err != nil {
+		ep := fmt.Sprintf("%s/%s", client.VolumesEP, createResp.DiskUUID)
+		if !provisionFailed(ctx, &resp.Diagnostics, c, "scamp_volume", "volume", createResp.DiskUUID, ep, plan.DeleteOnFailure.ValueBool(), plan.Timeouts, err) {
+			saveTainted(ctx, &resp.State, &plan, &resp.Diagnostics)
+		}
+		return
 	}
 
 	// Attach to VM if attached_vm_id is set
@@ -220,13 +337,13 @@ func (r *volumeResource) Create(ctx context.Context, req tfresource.CreateReques
 			"vm_uuid": wantAttachVMID.ValueString(),
 		}
 		var attachResp models.VolumeAttachResponse
-		if err := r.c.PostJSON(ctx, fmt.Sprintf("%s/%s/attach", client.VolumesEP, createResp.DiskUUID), attachPayload, &attachResp); err != nil {
+		if err := c.PostJSON(ctx, fmt.Sprintf("%s/%s/attach", client.VolumesEP, createResp.DiskUUID), attachPayload, &attachResp); err != nil {
 			resp.Diagnostics.AddError("Failed to attach volume to VM", err.Error())
 			return
 		}
 
 		// Wait for attached state
-		vol, err = r.waitForVolumeState(ctx, createResp.DiskUUID, []string{"attached"}, 5*time.Minute)
+		vol, err = waitForVolumeState(ctx, c, createResp.DiskUUID, []string{"attached"}, 5*time.Minute)
 		if err != nil {
 			resp.Diagnostics.AddWarning("Volume attached but state not confirmed", err.Error())
 		} else {
@@ -238,31 +355,47 @@ func (r *volumeResource) Create(ctx context.Context, req tfresource.CreateReques
 }
 
 func (r *volumeResource) Read(ctx context.Context, req tfresource.ReadRequest, resp *tfresource.ReadResponse) {
+	ctx, span := tracing.StartOperation(ctx, "scamp_volume", "Read")
+	defer tracing.End(span, &resp.Diagnostics)
+
 	var state volumeModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	c := regionalClient(ctx, r.c, state.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+	state.Region = types.StringValue(c.Region)
+
 	uuid := state.ID.ValueString()
+	tracing.SetUUID(ctx, uuid)
 	if uuid == "" {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 
 	var vol models.Volume
-	err := r.c.GetJSON(ctx, fmt.Sprintf("%s/%s", client.VolumesEP, uuid), nil, &vol)
-	if err != nil {
-		// Assume 404 - resource deleted
+	err := c.GetJSON(ctx, fmt.Sprintf("%s/%s", client.VolumesEP, uuid), nil, &vol)
+	if client.IsNotFound(err) {
 		resp.State.RemoveResource(ctx)
 		return
 	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read volume", err.Error())
+		return
+	}
 
 	r.setModelFromVolume(&state, &vol)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *volumeResource) Update(ctx context.Context, req tfresource.UpdateRequest, resp *tfresource.UpdateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "scamp_volume", "Update")
+	defer tracing.End(span, &resp.Diagnostics)
+
 	var plan volumeModel
 	var state volumeModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -271,7 +404,13 @@ func (r *volumeResource) Update(ctx context.Context, req tfresource.UpdateReques
 		return
 	}
 
+	c := regionalClient(ctx, r.c, plan.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+
 	uuid := state.ID.ValueString()
+	tracing.SetUUID(ctx, uuid)
 
 	// Check if attached_vm_id changed
 	oldVMID := state.AttachedVMID.ValueString()
@@ -280,12 +419,12 @@ func (r *volumeResource) Update(ctx context.Context, req tfresource.UpdateReques
 	if oldVMID != newVMID {
 		// Detach from old VM if was attached
 		if oldVMID != "" {
-			if err := r.c.PostJSON(ctx, fmt.Sprintf("%s/%s/detach", client.VolumesEP, uuid), nil, nil); err != nil {
+			if err := c.PostJSON(ctx, fmt.Sprintf("%s/%s/detach", client.VolumesEP, uuid), nil, nil); err != nil {
 				resp.Diagnostics.AddError("Failed to detach volume from VM", err.Error())
 				return
 			}
 			// Wait for detached/provisioned state
-			_, err := r.waitForVolumeState(ctx, uuid, []string{"provisioned", "detached"}, 5*time.Minute)
+			_, err := waitForVolumeState(ctx, c, uuid, []string{"provisioned", "detached"}, 5*time.Minute)
 			if err != nil {
 				resp.Diagnostics.AddWarning("Volume detached but state not confirmed", err.Error())
 			}
@@ -296,21 +435,35 @@ func (r *volumeResource) Update(ctx context.Context, req tfresource.UpdateReques
 			attachPayload := map[string]any{
 				"vm_uuid": newVMID,
 			}
-			if err := r.c.PostJSON(ctx, fmt.Sprintf("%s/%s/attach", client.VolumesEP, uuid), attachPayload, nil); err != nil {
+			if err := c.PostJSON(ctx, fmt.Sprintf("%s/%s/attach", client.VolumesEP, uuid), attachPayload, nil); err != nil {
 				resp.Diagnostics.AddError("Failed to attach volume to VM", err.Error())
 				return
 			}
 			// Wait for attached state
-			_, err := r.waitForVolumeState(ctx, uuid, []string{"attached"}, 5*time.Minute)
+			_, err := waitForVolumeState(ctx, c, uuid, []string{"attached"}, 5*time.Minute)
 			if err != nil {
 				resp.Diagnostics.AddWarning("Volume attached but state not confirmed", err.Error())
 			}
 		}
 	}
 
+	if !plan.TagsAll.Equal(state.TagsAll) || !plan.DeletionProtection.Equal(state.DeletionProtection) {
+		payload := map[string]any{
+			"tags":                tagsPayload(ctx, plan.TagsAll, &resp.Diagnostics),
+			"deletion_protection": plan.DeletionProtection.ValueBool(),
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := c.PatchJSON(ctx, fmt.Sprintf("%s/%s", client.VolumesEP, uuid), payload, nil); err != nil {
+			resp.Diagnostics.AddError("Failed to update volume", err.Error())
+			return
+		}
+	}
+
 	// Read final state
 	var vol models.Volume
-	err := r.c.GetJSON(ctx, fmt.Sprintf("%s/%s", client.VolumesEP, uuid), nil, &vol)
+	err := c.GetJSON(ctx, fmt.Sprintf("%s/%s", client.VolumesEP, uuid), nil, &vol)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read volume after update", err.Error())
 		return
@@ -321,31 +474,54 @@ func (r *volumeResource) Update(ctx context.Context, req tfresource.UpdateReques
 }
 
 func (r *volumeResource) Delete(ctx context.Context, req tfresource.DeleteRequest, resp *tfresource.DeleteResponse) {
+	ctx, span := tracing.StartOperation(ctx, "scamp_volume", "Delete")
+	defer tracing.End(span, &resp.Diagnostics)
+
 	var state volumeModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	timeout, diags := state.Timeouts.Delete(ctx, deleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := regionalClient(ctx, r.c, state.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+
 	uuid := state.ID.ValueString()
+	tracing.SetUUID(ctx, uuid)
 	if uuid == "" {
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		errDeletionProtected(resp, "volume")
+		return
+	}
+
 	// Detach from VM if attached
 	if !state.AttachedVMID.IsNull() && state.AttachedVMID.ValueString() != "" {
-		if err := r.c.PostJSON(ctx, fmt.Sprintf("%s/%s/detach", client.VolumesEP, uuid), nil, nil); err != nil {
+		if err := c.PostJSON(ctx, fmt.Sprintf("%s/%s/detach", client.VolumesEP, uuid), nil, nil); err != nil {
+			if client.IsNotFound(err) {
+				return
+			}
 			resp.Diagnostics.AddError("Failed to detach volume before deletion", err.Error())
 			return
 		}
 		// Wait for detached/provisioned state
-		_, err := r.waitForVolumeState(ctx, uuid, []string{"provisioned", "detached"}, 5*time.Minute)
+		_, err := waitForVolumeState(ctx, c, uuid, []string{"provisioned", "detached"}, 5*time.Minute)
 		if err != nil {
 			resp.Diagnostics.AddWarning("Volume detach not confirmed, proceeding with delete", err.Error())
 		}
 	}
 
-	if err := r.c.Delete(ctx, fmt.Sprintf("%s/%s", client.VolumesEP, uuid)); err != nil {
+	if err := deleteAndWait(ctx, c, "scamp_volume", uuid, fmt.Sprintf("%s/%s", client.VolumesEP, uuid), timeout); err != nil {
 		resp.Diagnostics.AddError("Failed to delete volume", err.Error())
 		return
 	}
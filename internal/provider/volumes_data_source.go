@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+
+	fwds "github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/serverscamp/terraform-provider-scamp/internal/client"
+	"github.com/serverscamp/terraform-provider-scamp/internal/models"
+	"github.com/serverscamp/terraform-provider-scamp/internal/tracing"
+)
+
+type volumesDataSource struct {
+	c *client.Client
+}
+
+func NewVolumesDataSource() fwds.DataSource { return &volumesDataSource{} }
+
+func (d *volumesDataSource) Metadata(_ context.Context, _ fwds.MetadataRequest, resp *fwds.MetadataResponse) {
+	resp.TypeName = "scamp_volumes"
+}
+
+func (d *volumesDataSource) Schema(_ context.Context, _ fwds.SchemaRequest, resp *fwds.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Description: "Retrieves list of volumes, optionally filtered.",
+		Attributes: map[string]dsschema.Attribute{
+			"region": dsschema.StringAttribute{
+				Optional:    true,
+				Description: "Region to read from. Defaults to the provider region.",
+			},
+			"items": dsschema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of volumes.",
+				NestedObject: dsschema.NestedAttributeObject{
+					Attributes: map[string]dsschema.Attribute{
+						"id": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "UUID of the volume.",
+						},
+						"display_name": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Display name of the volume.",
+						},
+						"size_gb": dsschema.Int64Attribute{
+							Computed:    true,
+							Description: "Size of the volume in GB.",
+						},
+						"storage_class_id": dsschema.Int64Attribute{
+							Computed:    true,
+							Description: "ID of the storage class.",
+						},
+						"attached_vm_id": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "UUID of the VM the volume is attached to (null if not attached).",
+						},
+						"state": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "State of the volume.",
+						},
+						"sds_pool_name": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the SDS pool.",
+						},
+						"read_iops_limit": dsschema.Int64Attribute{
+							Computed:    true,
+							Description: "Read IOPS limit.",
+						},
+						"write_iops_limit": dsschema.Int64Attribute{
+							Computed:    true,
+							Description: "Write IOPS limit.",
+						},
+						"read_bandwidth_limit": dsschema.Int64Attribute{
+							Computed:    true,
+							Description: "Read bandwidth limit (MB/s).",
+						},
+						"write_bandwidth_limit": dsschema.Int64Attribute{
+							Computed:    true,
+							Description: "Write bandwidth limit (MB/s).",
+						},
+						"tags": dsschema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Tags of the volume.",
+						},
+						"created_at": dsschema.StringAttribute{
+							Computed:    true,
+							Description: "Timestamp when the volume was created.",
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]dsschema.Block{
+			"filter": dsschema.SingleNestedBlock{
+				Description: "Only return volumes matching all of the given criteria.",
+				Attributes: map[string]dsschema.Attribute{
+					"state": dsschema.StringAttribute{
+						Optional:    true,
+						Description: "State of the volume.",
+					},
+					"name_regex": dsschema.StringAttribute{
+						Optional:    true,
+						Description: "Regular expression matched against display_name.",
+					},
+					"attached_vm_id": dsschema.StringAttribute{
+						Optional:    true,
+						Description: "UUID of the VM the volume is attached to.",
+					},
+					"tags": dsschema.MapAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "Only return volumes having all of the given tags.",
+					},
+					"tags_any": dsschema.MapAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "Only return volumes having at least one of the given tags.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *volumesDataSource) Configure(_ context.Context, req fwds.ConfigureRequest, _ *fwds.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.c = req.ProviderData.(*client.Client)
+}
+
+type volumeItemModel struct {
+	ID                  types.String `tfsdk:"id"`
+	DisplayName         types.String `tfsdk:"display_name"`
+	SizeGB              types.Int64  `tfsdk:"size_gb"`
+	StorageClassID      types.Int64  `tfsdk:"storage_class_id"`
+	AttachedVMID        types.String `tfsdk:"attached_vm_id"`
+	State               types.String `tfsdk:"state"`
+	SDSPoolName         types.String `tfsdk:"sds_pool_name"`
+	ReadIOPSLimit       types.Int64  `tfsdk:"read_iops_limit"`
+	WriteIOPSLimit      types.Int64  `tfsdk:"write_iops_limit"`
+	ReadBandwidthLimit  types.Int64  `tfsdk:"read_bandwidth_limit"`
+	WriteBandwidthLimit types.Int64  `tfsdk:"write_bandwidth_limit"`
+	Tags                types.Map    `tfsdk:"tags"`
+	CreatedAt           types.String `tfsdk:"created_at"`
+}
+
+type volumesFilterModel struct {
+	State        types.String `tfsdk:"state"`
+	NameRegex    types.String `tfsdk:"name_regex"`
+	AttachedVMID types.String `tfsdk:"attached_vm_id"`
+	Tags         types.Map    `tfsdk:"tags"`
+	TagsAny      types.Map    `tfsdk:"tags_any"`
+}
+
+type volumesDataSourceModel struct {
+	Filter *volumesFilterModel `tfsdk:"filter"`
+	Items  []volumeItemModel   `tfsdk:"items"`
+	Region types.String        `tfsdk:"region"`
+}
+
+func volumeItemFromAPI(vol models.Volume) volumeItemModel {
+	item := volumeItemModel{
+		ID:                  types.StringValue(vol.DiskUUID),
+		DisplayName:         types.StringValue(vol.DisplayName),
+		SizeGB:              types.Int64Value(int64(vol.SizeGB)),
+		StorageClassID:      types.Int64Value(int64(vol.StorageClassID)),
+		AttachedVMID:        types.StringNull(),
+		State:               types.StringValue(vol.State),
+		SDSPoolName:         types.StringValue(vol.SDSPoolName),
+		ReadIOPSLimit:       types.Int64Null(),
+		WriteIOPSLimit:      types.Int64Null(),
+		ReadBandwidthLimit:  types.Int64Null(),
+		WriteBandwidthLimit: types.Int64Null(),
+		Tags:                tagsValue(vol.Tags),
+		CreatedAt:           types.StringValue(vol.CreatedAt),
+	}
+	if vol.VMUUID != nil {
+		item.AttachedVMID = types.StringValue(*vol.VMUUID)
+	}
+	if vol.Limits != nil {
+		item.ReadIOPSLimit = types.Int64Value(int64(vol.Limits.ReadIOPSLimit))
+		item.WriteIOPSLimit = types.Int64Value(int64(vol.Limits.WriteIOPSLimit))
+		item.ReadBandwidthLimit = types.Int64Value(int64(vol.Limits.ReadBandwidthLimit))
+		item.WriteBandwidthLimit = types.Int64Value(int64(vol.Limits.WriteBandwidthLimit))
+	}
+	return item
+}
+
+func (d *volumesDataSource) Read(ctx context.Context, req fwds.ReadRequest, resp *fwds.ReadResponse) {
+	ctx, span := tracing.StartDataSourceRead(ctx, "scamp_volumes")
+	defer tracing.End(span, &resp.Diagnostics)
+
+	var state volumesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := state.Filter
+	if filter == nil {
+		filter = &volumesFilterModel{}
+	}
+	nameRe := compileNameRegex(filter.NameRegex, path.Root("filter").AtName("name_regex"), &resp.Diagnostics)
+	tags := tagsPayload(ctx, filter.Tags, &resp.Diagnostics)
+	tagsAny := tagsPayload(ctx, filter.TagsAny, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := regionalClient(ctx, d.c, state.Region, &resp.Diagnostics)
+	if c == nil {
+		return
+	}
+
+	q := tagQuery(nil, "tag", tags)
+	q = tagQuery(q, "tag_any", tagsAny)
+	volumes, err := listAll(ctx, c, client.VolumesEP, q, func(r *models.VolumesListResponse) ([]models.Volume, int) {
+		return r.Items, r.Total
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list volumes", err.Error())
+		return
+	}
+
+	state.Items = []volumeItemModel{}
+	for _, vol := range volumes {
+		if !hasAllTags(vol.Tags, tags) || !hasAnyTag(vol.Tags, tagsAny) {
+			continue
+		}
+		attachedVMID := ""
+		if vol.VMUUID != nil {
+			attachedVMID = *vol.VMUUID
+		}
+		if !matchString(filter.State, vol.State) || !matchString(filter.AttachedVMID, attachedVMID) {
+			continue
+		}
+		if nameRe != nil && !nameRe.MatchString(vol.DisplayName) {
+			continue
+		}
+		state.Items = append(state.Items, volumeItemFromAPI(vol))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,126 @@
+package tracing
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/serverscamp/terraform-provider-scamp"
+
+// Attribute keys used on provider spans.
+const (
+	ResourceTypeKey = attribute.Key("scamp.resource.type")
+	UUIDKey         = attribute.Key("scamp.resource.uuid")
+	EndpointKey     = attribute.Key("scamp.endpoint")
+	StatusKey       = attribute.Key("scamp.status")
+	RetriesKey      = attribute.Key("scamp.retries")
+)
+
+// Setup installs an OTLP/HTTP trace exporter when the standard OTEL_* env vars
+// request one. Otherwise the global no-op tracer provider stays in place.
+// The returned function flushes and shuts down the exporter.
+func Setup(ctx context.Context, version string) (func(context.Context) error, error) {
+	noop := func(context.Context) error { return nil }
+	if !enabled() {
+		return noop, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return noop, err
+	}
+
+	// resource.Default() honours OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES.
+	res, err := resource.Merge(
+		resource.NewSchemaless(
+			semconv.ServiceName("terraform-provider-scamp"),
+			semconv.ServiceVersion(version),
+		),
+		resource.Default(),
+	)
+	if err != nil {
+		return noop, err
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	return tp.Shutdown, nil
+}
+
+// enabled reports whether tracing was requested through OTEL_* env vars.
+func enabled() bool {
+	if strings.EqualFold(os.Getenv("OTEL_SDK_DISABLED"), "true") {
+		return false
+	}
+	switch strings.ToLower(os.Getenv("OTEL_TRACES_EXPORTER")) {
+	case "none":
+		return false
+	case "otlp":
+		return true
+	}
+	return os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" || os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != ""
+}
+
+// Start starts a span named name as a child of any span in ctx.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// StartOperation starts a span for a resource CRUD operation,
+// e.g. StartOperation(ctx, "scamp_vm", "Create").
+func StartOperation(ctx context.Context, typeName, op string) (context.Context, trace.Span) {
+	return Start(ctx, typeName+"."+op, ResourceTypeKey.String(typeName))
+}
+
+// StartDataSourceRead starts a span for a data source Read.
+func StartDataSourceRead(ctx context.Context, typeName string) (context.Context, trace.Span) {
+	return Start(ctx, "data."+typeName+".Read", ResourceTypeKey.String(typeName))
+}
+
+// SetUUID records the UUID (or ID) of the object an operation works on.
+func SetUUID(ctx context.Context, uuid string) {
+	if uuid == "" {
+		return
+	}
+	trace.SpanFromContext(ctx).SetAttributes(UUIDKey.String(uuid))
+}
+
+// End ends span, marking it as failed if diags contain errors.
+func End(span trace.Span, diags *diag.Diagnostics) {
+	if diags != nil && diags.HasError() {
+		for _, d := range diags.Errors() {
+			span.RecordError(diagError(d.Summary() + ": " + d.Detail()))
+		}
+		span.SetStatus(codes.Error, diags.Errors()[0].Summary())
+	}
+	span.End()
+}
+
+// EndErr ends span, marking it as failed if err is not nil.
+func EndErr(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+type diagError string
+
+func (e diagError) Error() string { return string(e) }
@@ -5,14 +5,24 @@ import (
     "github.com/hashicorp/terraform-plugin-framework/providerserver"
     "github.com/hashicorp/terraform-plugin-log/tflog"
     "github.com/serverscamp/terraform-provider-scamp/internal/provider"
+    "github.com/serverscamp/terraform-provider-scamp/internal/tracing"
 )
 
-var version = "0.1.0"
+// version is set at build time via -ldflags "-X main.version=...".
+var version = "dev"
 
 func main() {
     ctx := context.Background()
     tflog.Info(ctx, "Starting SCAMP Terraform Provider", map[string]any{"version": version})
-    providerserver.Serve(ctx, provider.New, providerserver.ServeOpts{
+
+    // Tracing is a no-op unless OTEL_* env vars configure an exporter.
+    shutdown, err := tracing.Setup(ctx, version)
+    if err != nil {
+        tflog.Warn(ctx, "Failed to set up OpenTelemetry tracing", map[string]any{"error": err.Error()})
+    }
+    defer func() { _ = shutdown(context.Background()) }()
+
+    providerserver.Serve(ctx, provider.New(version), providerserver.ServeOpts{
         Address: "registry.terraform.io/serverscamp/scamp",
     })
 }